@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _products_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on Pagination with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -179,6 +182,10 @@ func (m *Product) validate(all bool) error {
 		// no validation rules for Thumbnail
 	}
 
+	if m.Sku != nil {
+		// no validation rules for Sku
+	}
+
 	if len(errors) > 0 {
 		return ProductMultiError(errors)
 	}
@@ -321,6 +328,21 @@ func (m *CreateProductRequest) validate(all bool) error {
 		// no validation rules for Thumbnail
 	}
 
+	if m.Sku != nil {
+
+		if utf8.RuneCountInString(m.GetSku()) < 1 {
+			err := CreateProductRequestValidationError{
+				field:  "Sku",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateProductRequestMultiError(errors)
 	}
@@ -505,44 +527,47 @@ var _ interface {
 	ErrorName() string
 } = CreateProductResponseValidationError{}
 
-// Validate checks the field values on GetProductRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetProductRequest) Validate() error {
+// Validate checks the field values on BatchError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProductRequestMultiError, or nil if none found.
-func (m *GetProductRequest) ValidateAll() error {
+// ValidateAll checks the field values on BatchError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchErrorMultiError, or
+// nil if none found.
+func (m *BatchError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductRequest) validate(all bool) error {
+func (m *BatchError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return GetProductRequestMultiError(errors)
+		return BatchErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductRequestMultiError is an error wrapping multiple validation errors
-// returned by GetProductRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetProductRequestMultiError []error
+// BatchErrorMultiError is an error wrapping multiple validation errors
+// returned by BatchError.ValidateAll() if the designated constraints aren't met.
+type BatchErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductRequestMultiError) Error() string {
+func (m BatchErrorMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -551,11 +576,11 @@ func (m GetProductRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductRequestMultiError) AllErrors() []error { return m }
+func (m BatchErrorMultiError) AllErrors() []error { return m }
 
-// GetProductRequestValidationError is the validation error returned by
-// GetProductRequest.Validate if the designated constraints aren't met.
-type GetProductRequestValidationError struct {
+// BatchErrorValidationError is the validation error returned by
+// BatchError.Validate if the designated constraints aren't met.
+type BatchErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -563,24 +588,22 @@ type GetProductRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductRequestValidationError) Field() string { return e.field }
+func (e BatchErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductRequestValidationError) Reason() string { return e.reason }
+func (e BatchErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductRequestValidationError) Cause() error { return e.cause }
+func (e BatchErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductRequestValidationError) Key() bool { return e.key }
+func (e BatchErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductRequestValidationError) ErrorName() string {
-	return "GetProductRequestValidationError"
-}
+func (e BatchErrorValidationError) ErrorName() string { return "BatchErrorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetProductRequestValidationError) Error() string {
+func (e BatchErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -592,14 +615,14 @@ func (e GetProductRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductRequest.%s: %s%s",
+		"invalid %sBatchError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductRequestValidationError{}
+var _ error = BatchErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -607,73 +630,91 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductRequestValidationError{}
+} = BatchErrorValidationError{}
 
-// Validate checks the field values on GetProductResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BatchCreateProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductResponse) Validate() error {
+func (m *BatchCreateProductsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchCreateProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProductResponseMultiError, or nil if none found.
-func (m *GetProductResponse) ValidateAll() error {
+// BatchCreateProductsRequestMultiError, or nil if none found.
+func (m *BatchCreateProductsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductResponse) validate(all bool) error {
+func (m *BatchCreateProductsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetProduct()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetProductResponseValidationError{
-					field:  "Product",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(m.GetProducts()) < 1 {
+		err := BatchCreateProductsRequestValidationError{
+			field:  "Products",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateProductsRequestValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateProductsRequestValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetProductResponseValidationError{
-					field:  "Product",
+				return BatchCreateProductsRequestValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetProductResponseValidationError{
-				field:  "Product",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Atomic
+
 	if len(errors) > 0 {
-		return GetProductResponseMultiError(errors)
+		return BatchCreateProductsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductResponseMultiError is an error wrapping multiple validation errors
-// returned by GetProductResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetProductResponseMultiError []error
+// BatchCreateProductsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchCreateProductsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchCreateProductsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductResponseMultiError) Error() string {
+func (m BatchCreateProductsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -682,11 +723,11 @@ func (m GetProductResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductResponseMultiError) AllErrors() []error { return m }
+func (m BatchCreateProductsRequestMultiError) AllErrors() []error { return m }
 
-// GetProductResponseValidationError is the validation error returned by
-// GetProductResponse.Validate if the designated constraints aren't met.
-type GetProductResponseValidationError struct {
+// BatchCreateProductsRequestValidationError is the validation error returned
+// by BatchCreateProductsRequest.Validate if the designated constraints aren't met.
+type BatchCreateProductsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -694,24 +735,24 @@ type GetProductResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductResponseValidationError) Field() string { return e.field }
+func (e BatchCreateProductsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductResponseValidationError) Reason() string { return e.reason }
+func (e BatchCreateProductsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductResponseValidationError) Cause() error { return e.cause }
+func (e BatchCreateProductsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductResponseValidationError) Key() bool { return e.key }
+func (e BatchCreateProductsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductResponseValidationError) ErrorName() string {
-	return "GetProductResponseValidationError"
+func (e BatchCreateProductsRequestValidationError) ErrorName() string {
+	return "BatchCreateProductsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductResponseValidationError) Error() string {
+func (e BatchCreateProductsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -723,14 +764,14 @@ func (e GetProductResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductResponse.%s: %s%s",
+		"invalid %sBatchCreateProductsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductResponseValidationError{}
+var _ error = BatchCreateProductsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -738,55 +779,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductResponseValidationError{}
+} = BatchCreateProductsRequestValidationError{}
 
-// Validate checks the field values on ListProductsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BatchCreateProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProductsRequest) Validate() error {
+func (m *BatchCreateProductsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProductsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchCreateProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProductsRequestMultiError, or nil if none found.
-func (m *ListProductsRequest) ValidateAll() error {
+// BatchCreateProductsResponseMultiError, or nil if none found.
+func (m *BatchCreateProductsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProductsRequest) validate(all bool) error {
+func (m *BatchCreateProductsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Pagination != nil {
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetPagination()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProductsRequestValidationError{
-						field:  "Pagination",
+					errors = append(errors, BatchCreateProductsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProductsRequestValidationError{
-						field:  "Pagination",
+					errors = append(errors, BatchCreateProductsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListProductsRequestValidationError{
-					field:  "Pagination",
+				return BatchCreateProductsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -796,19 +838,19 @@ func (m *ListProductsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListProductsRequestMultiError(errors)
+		return BatchCreateProductsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProductsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProductsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListProductsRequestMultiError []error
+// BatchCreateProductsResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchCreateProductsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchCreateProductsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProductsRequestMultiError) Error() string {
+func (m BatchCreateProductsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -817,11 +859,12 @@ func (m ListProductsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProductsRequestMultiError) AllErrors() []error { return m }
+func (m BatchCreateProductsResponseMultiError) AllErrors() []error { return m }
 
-// ListProductsRequestValidationError is the validation error returned by
-// ListProductsRequest.Validate if the designated constraints aren't met.
-type ListProductsRequestValidationError struct {
+// BatchCreateProductsResponseValidationError is the validation error returned
+// by BatchCreateProductsResponse.Validate if the designated constraints
+// aren't met.
+type BatchCreateProductsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -829,24 +872,24 @@ type ListProductsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProductsRequestValidationError) Field() string { return e.field }
+func (e BatchCreateProductsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProductsRequestValidationError) Reason() string { return e.reason }
+func (e BatchCreateProductsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProductsRequestValidationError) Cause() error { return e.cause }
+func (e BatchCreateProductsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProductsRequestValidationError) Key() bool { return e.key }
+func (e BatchCreateProductsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProductsRequestValidationError) ErrorName() string {
-	return "ListProductsRequestValidationError"
+func (e BatchCreateProductsResponseValidationError) ErrorName() string {
+	return "BatchCreateProductsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProductsRequestValidationError) Error() string {
+func (e BatchCreateProductsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -858,14 +901,14 @@ func (e ListProductsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProductsRequest.%s: %s%s",
+		"invalid %sBatchCreateProductsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProductsRequestValidationError{}
+var _ error = BatchCreateProductsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -873,107 +916,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProductsRequestValidationError{}
+} = BatchCreateProductsResponseValidationError{}
 
-// Validate checks the field values on ListProductsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BatchDeleteProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProductsResponse) Validate() error {
+func (m *BatchDeleteProductsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProductsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchDeleteProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProductsResponseMultiError, or nil if none found.
-func (m *ListProductsResponse) ValidateAll() error {
+// BatchDeleteProductsRequestMultiError, or nil if none found.
+func (m *BatchDeleteProductsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProductsResponse) validate(all bool) error {
+func (m *BatchDeleteProductsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProducts() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProductsResponseValidationError{
-						field:  fmt.Sprintf("Products[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProductsResponseValidationError{
-						field:  fmt.Sprintf("Products[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProductsResponseValidationError{
-					field:  fmt.Sprintf("Products[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListProductsResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListProductsResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(m.GetIds()) < 1 {
+		err := BatchDeleteProductsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
 		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListProductsResponseValidationError{
-				field:  "Pagination",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Atomic
+
 	if len(errors) > 0 {
-		return ListProductsResponseMultiError(errors)
+		return BatchDeleteProductsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProductsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListProductsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListProductsResponseMultiError []error
+// BatchDeleteProductsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchDeleteProductsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDeleteProductsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProductsResponseMultiError) Error() string {
+func (m BatchDeleteProductsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -982,11 +975,11 @@ func (m ListProductsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProductsResponseMultiError) AllErrors() []error { return m }
+func (m BatchDeleteProductsRequestMultiError) AllErrors() []error { return m }
 
-// ListProductsResponseValidationError is the validation error returned by
-// ListProductsResponse.Validate if the designated constraints aren't met.
-type ListProductsResponseValidationError struct {
+// BatchDeleteProductsRequestValidationError is the validation error returned
+// by BatchDeleteProductsRequest.Validate if the designated constraints aren't met.
+type BatchDeleteProductsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -994,24 +987,3401 @@ type ListProductsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProductsResponseValidationError) Field() string { return e.field }
+func (e BatchDeleteProductsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProductsResponseValidationError) Reason() string { return e.reason }
+func (e BatchDeleteProductsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProductsResponseValidationError) Cause() error { return e.cause }
+func (e BatchDeleteProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteProductsRequestValidationError) ErrorName() string {
+	return "BatchDeleteProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteProductsRequestValidationError{}
+
+// Validate checks the field values on BatchDeleteProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteProductsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteProductsResponseMultiError, or nil if none found.
+func (m *BatchDeleteProductsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteProductsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDeleteProductsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDeleteProductsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeleteProductsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchDeleteProductsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteProductsResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchDeleteProductsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDeleteProductsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteProductsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteProductsResponseMultiError) AllErrors() []error { return m }
+
+// BatchDeleteProductsResponseValidationError is the validation error returned
+// by BatchDeleteProductsResponse.Validate if the designated constraints
+// aren't met.
+type BatchDeleteProductsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteProductsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteProductsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteProductsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteProductsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteProductsResponseValidationError) ErrorName() string {
+	return "BatchDeleteProductsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteProductsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteProductsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteProductsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteProductsResponseValidationError{}
+
+// Validate checks the field values on GetProductRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductRequestMultiError, or nil if none found.
+func (m *GetProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProductRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductRequestMultiError) AllErrors() []error { return m }
+
+// GetProductRequestValidationError is the validation error returned by
+// GetProductRequest.Validate if the designated constraints aren't met.
+type GetProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductRequestValidationError) ErrorName() string {
+	return "GetProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductRequestValidationError{}
+
+// Validate checks the field values on GetProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductResponseMultiError, or nil if none found.
+func (m *GetProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductResponseMultiError is an error wrapping multiple validation errors
+// returned by GetProductResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductResponseMultiError) AllErrors() []error { return m }
+
+// GetProductResponseValidationError is the validation error returned by
+// GetProductResponse.Validate if the designated constraints aren't met.
+type GetProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductResponseValidationError) ErrorName() string {
+	return "GetProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductResponseValidationError{}
+
+// Validate checks the field values on ListProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProductsRequestMultiError, or nil if none found.
+func (m *ListProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTags()) > 20 {
+		err := ListProductsRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := ListProductsRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := TagMatch_name[int32(m.GetTagMatch())]; !ok {
+		err := ListProductsRequestValidationError{
+			field:  "TagMatch",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) > 20 {
+		err := ListProductsRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 20 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) < 1 {
+				err := ListProductsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_ListProductsRequest_Attributes_Pattern.MatchString(key) {
+				err := ListProductsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[^.$]+$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Attributes[key]
+		}
+	}
+
+	if !_ListProductsRequest_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
+		err := ListProductsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value does not match regex pattern \"^\\\\s*(price|name|created_at)(\\\\s+(asc|desc))?\\\\s*(,\\\\s*(price|name|created_at)(\\\\s+(asc|desc))?\\\\s*)*$|^$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Pagination != nil {
+
+		if all {
+			switch v := interface{}(m.GetPagination()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProductsRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProductsRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProductsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Category != nil {
+		// no validation rules for Category
+	}
+
+	if m.MinPrice != nil {
+
+		if m.GetMinPrice() < 0 {
+			err := ListProductsRequestValidationError{
+				field:  "MinPrice",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MaxPrice != nil {
+
+		if m.GetMaxPrice() < 0 {
+			err := ListProductsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.HasThumbnail != nil {
+		// no validation rules for HasThumbnail
+	}
+
+	if len(errors) > 0 {
+		return ListProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProductsRequestMultiError) AllErrors() []error { return m }
+
+// ListProductsRequestValidationError is the validation error returned by
+// ListProductsRequest.Validate if the designated constraints aren't met.
+type ListProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProductsRequestValidationError) ErrorName() string {
+	return "ListProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProductsRequestValidationError{}
+
+var _ListProductsRequest_Attributes_Pattern = regexp.MustCompile("^[^.$]+$")
+
+var _ListProductsRequest_OrderBy_Pattern = regexp.MustCompile("^\\s*(price|name|created_at)(\\s+(asc|desc))?\\s*(,\\s*(price|name|created_at)(\\s+(asc|desc))?\\s*)*$|^$")
+
+// Validate checks the field values on ListProductsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProductsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProductsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProductsResponseMultiError, or nil if none found.
+func (m *ListProductsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProductsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProductsResponseValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListProductsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListProductsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListProductsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListProductsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProductsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProductsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProductsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProductsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProductsResponseMultiError) AllErrors() []error { return m }
+
+// ListProductsResponseValidationError is the validation error returned by
+// ListProductsResponse.Validate if the designated constraints aren't met.
+type ListProductsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProductsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProductsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProductsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
 func (e ListProductsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProductsResponseValidationError) ErrorName() string {
-	return "ListProductsResponseValidationError"
+func (e ListProductsResponseValidationError) ErrorName() string {
+	return "ListProductsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProductsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProductsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProductsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProductsResponseValidationError{}
+
+// Validate checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProductRequestMultiError, or nil if none found.
+func (m *UpdateProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Attributes
+
+	if m.Name != nil {
+
+		if utf8.RuneCountInString(m.GetName()) < 3 {
+			err := UpdateProductRequestValidationError{
+				field:  "Name",
+				reason: "value length must be at least 3 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) < 3 {
+			err := UpdateProductRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at least 3 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Price != nil {
+
+		if m.GetPrice() <= 0 {
+			err := UpdateProductRequestValidationError{
+				field:  "Price",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Category != nil {
+		// no validation rules for Category
+	}
+
+	if m.Thumbnail != nil {
+		// no validation rules for Thumbnail
+	}
+
+	if m.Sku != nil {
+
+		if utf8.RuneCountInString(m.GetSku()) < 1 {
+			err := UpdateProductRequestValidationError{
+				field:  "Sku",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProductRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProductRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProductRequestValidationError is the validation error returned by
+// UpdateProductRequest.Validate if the designated constraints aren't met.
+type UpdateProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProductRequestValidationError) ErrorName() string {
+	return "UpdateProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProductRequestValidationError{}
+
+// Validate checks the field values on UpdateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProductResponseMultiError, or nil if none found.
+func (m *UpdateProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UpdateProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProductResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProductResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProductResponseValidationError is the validation error returned by
+// UpdateProductResponse.Validate if the designated constraints aren't met.
+type UpdateProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProductResponseValidationError) ErrorName() string {
+	return "UpdateProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProductResponseValidationError{}
+
+// Validate checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductRequestMultiError, or nil if none found.
+func (m *DeleteProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProductRequestValidationError is the validation error returned by
+// DeleteProductRequest.Validate if the designated constraints aren't met.
+type DeleteProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductRequestValidationError) ErrorName() string {
+	return "DeleteProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductRequestValidationError{}
+
+// Validate checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductResponseMultiError, or nil if none found.
+func (m *DeleteProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProductResponseValidationError is the validation error returned by
+// DeleteProductResponse.Validate if the designated constraints aren't met.
+type DeleteProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductResponseValidationError) ErrorName() string {
+	return "DeleteProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductResponseValidationError{}
+
+// Validate checks the field values on SearchProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchProductsRequestMultiError, or nil if none found.
+func (m *SearchProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	if len(m.GetTags()) > 20 {
+		err := SearchProductsRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := SearchProductsRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := TagMatch_name[int32(m.GetTagMatch())]; !ok {
+		err := SearchProductsRequestValidationError{
+			field:  "TagMatch",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) > 20 {
+		err := SearchProductsRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 20 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) < 1 {
+				err := SearchProductsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_SearchProductsRequest_Attributes_Pattern.MatchString(key) {
+				err := SearchProductsRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", key),
+					reason: "value does not match regex pattern \"^[^.$]+$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Attributes[key]
+		}
+	}
+
+	if !_SearchProductsRequest_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
+		err := SearchProductsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value does not match regex pattern \"^\\\\s*(relevance|price|name|created_at)(\\\\s+(asc|desc))?\\\\s*(,\\\\s*(relevance|price|name|created_at)(\\\\s+(asc|desc))?\\\\s*)*$|^$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPriceBuckets()) > 20 {
+		err := SearchProductsRequestValidationError{
+			field:  "PriceBuckets",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPriceBuckets() {
+		_, _ = idx, item
+
+		if item < 0 {
+			err := SearchProductsRequestValidationError{
+				field:  fmt.Sprintf("PriceBuckets[%v]", idx),
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Pagination != nil {
+
+		if all {
+			switch v := interface{}(m.GetPagination()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchProductsRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchProductsRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchProductsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Category != nil {
+		// no validation rules for Category
+	}
+
+	if m.MinPrice != nil {
+
+		if m.GetMinPrice() < 0 {
+			err := SearchProductsRequestValidationError{
+				field:  "MinPrice",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MaxPrice != nil {
+
+		if m.GetMaxPrice() < 0 {
+			err := SearchProductsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchProductsRequestMultiError) AllErrors() []error { return m }
+
+// SearchProductsRequestValidationError is the validation error returned by
+// SearchProductsRequest.Validate if the designated constraints aren't met.
+type SearchProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchProductsRequestValidationError) ErrorName() string {
+	return "SearchProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchProductsRequestValidationError{}
+
+var _SearchProductsRequest_Attributes_Pattern = regexp.MustCompile("^[^.$]+$")
+
+var _SearchProductsRequest_OrderBy_Pattern = regexp.MustCompile("^\\s*(relevance|price|name|created_at)(\\s+(asc|desc))?\\s*(,\\s*(relevance|price|name|created_at)(\\s+(asc|desc))?\\s*)*$|^$")
+
+// Validate checks the field values on SearchMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchMatchMultiError, or
+// nil if none found.
+func (m *SearchMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMatchValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMatchValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMatchValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMatchMultiError is an error wrapping multiple validation errors
+// returned by SearchMatch.ValidateAll() if the designated constraints aren't met.
+type SearchMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMatchMultiError) AllErrors() []error { return m }
+
+// SearchMatchValidationError is the validation error returned by
+// SearchMatch.Validate if the designated constraints aren't met.
+type SearchMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMatchValidationError) ErrorName() string { return "SearchMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMatchValidationError{}
+
+// Validate checks the field values on Highlight with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Highlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Highlight with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HighlightMultiError, or nil
+// if none found.
+func (m *Highlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Highlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return HighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// HighlightMultiError is an error wrapping multiple validation errors returned
+// by Highlight.ValidateAll() if the designated constraints aren't met.
+type HighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HighlightMultiError) AllErrors() []error { return m }
+
+// HighlightValidationError is the validation error returned by
+// Highlight.Validate if the designated constraints aren't met.
+type HighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HighlightValidationError) ErrorName() string { return "HighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HighlightValidationError{}
+
+// Validate checks the field values on Facets with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Facets) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Facets with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FacetsMultiError, or nil if none found.
+func (m *Facets) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Facets) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FacetsValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FacetsValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPrices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FacetsValidationError{
+					field:  fmt.Sprintf("Prices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FacetsValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FacetsValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FacetsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FacetsMultiError is an error wrapping multiple validation errors returned by
+// Facets.ValidateAll() if the designated constraints aren't met.
+type FacetsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FacetsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FacetsMultiError) AllErrors() []error { return m }
+
+// FacetsValidationError is the validation error returned by Facets.Validate if
+// the designated constraints aren't met.
+type FacetsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FacetsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FacetsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FacetsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FacetsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FacetsValidationError) ErrorName() string { return "FacetsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FacetsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFacets.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FacetsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FacetsValidationError{}
+
+// Validate checks the field values on SearchProductsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchProductsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchProductsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchProductsResponseMultiError, or nil if none found.
+func (m *SearchProductsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchProductsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchProductsResponseValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchProductsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchProductsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchProductsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchProductsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchProductsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchProductsResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetFacets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchProductsResponseValidationError{
+					field:  "Facets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchProductsResponseValidationError{
+					field:  "Facets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFacets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchProductsResponseValidationError{
+				field:  "Facets",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return SearchProductsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchProductsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchProductsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchProductsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchProductsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchProductsResponseMultiError) AllErrors() []error { return m }
+
+// SearchProductsResponseValidationError is the validation error returned by
+// SearchProductsResponse.Validate if the designated constraints aren't met.
+type SearchProductsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchProductsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchProductsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchProductsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchProductsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchProductsResponseValidationError) ErrorName() string {
+	return "SearchProductsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchProductsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchProductsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchProductsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchProductsResponseValidationError{}
+
+// Validate checks the field values on SuggestProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestProductsRequestMultiError, or nil if none found.
+func (m *SuggestProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPrefix()); l < 1 || l > 100 {
+		err := SuggestProductsRequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 50 {
+		err := SuggestProductsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuggestProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestProductsRequestMultiError) AllErrors() []error { return m }
+
+// SuggestProductsRequestValidationError is the validation error returned by
+// SuggestProductsRequest.Validate if the designated constraints aren't met.
+type SuggestProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestProductsRequestValidationError) ErrorName() string {
+	return "SuggestProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestProductsRequestValidationError{}
+
+// Validate checks the field values on Suggestion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Suggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Suggestion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuggestionMultiError, or
+// nil if none found.
+func (m *Suggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Suggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return SuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestionMultiError is an error wrapping multiple validation errors
+// returned by Suggestion.ValidateAll() if the designated constraints aren't met.
+type SuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestionMultiError) AllErrors() []error { return m }
+
+// SuggestionValidationError is the validation error returned by
+// Suggestion.Validate if the designated constraints aren't met.
+type SuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestionValidationError) ErrorName() string { return "SuggestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestionValidationError{}
+
+// Validate checks the field values on SuggestProductsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestProductsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestProductsResponseMultiError, or nil if none found.
+func (m *SuggestProductsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestProductsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuggestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestProductsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestProductsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestProductsResponseValidationError{
+					field:  fmt.Sprintf("Suggestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestProductsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestProductsResponseMultiError is an error wrapping multiple validation
+// errors returned by SuggestProductsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestProductsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestProductsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestProductsResponseMultiError) AllErrors() []error { return m }
+
+// SuggestProductsResponseValidationError is the validation error returned by
+// SuggestProductsResponse.Validate if the designated constraints aren't met.
+type SuggestProductsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestProductsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestProductsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestProductsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestProductsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestProductsResponseValidationError) ErrorName() string {
+	return "SuggestProductsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestProductsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestProductsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestProductsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestProductsResponseValidationError{}
+
+// Validate checks the field values on ImportOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportOptionsMultiError, or
+// nil if none found.
+func (m *ImportOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportOptions_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ImportOptionsValidationError{
+			field:  "Format",
+			reason: "value must not be in list [FILE_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := FileFormat_name[int32(m.GetFormat())]; !ok {
+		err := ImportOptionsValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOptionsMultiError is an error wrapping multiple validation errors
+// returned by ImportOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ImportOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOptionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOptionsMultiError) AllErrors() []error { return m }
+
+// ImportOptionsValidationError is the validation error returned by
+// ImportOptions.Validate if the designated constraints aren't met.
+type ImportOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOptionsValidationError) ErrorName() string { return "ImportOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOptionsValidationError{}
+
+var _ImportOptions_Format_NotInLookup = map[FileFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ImportProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportProductsRequestMultiError, or nil if none found.
+func (m *ImportProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofPayloadPresent := false
+	switch v := m.Payload.(type) {
+	case *ImportProductsRequest_Options:
+		if v == nil {
+			err := ImportProductsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportProductsRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportProductsRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportProductsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportProductsRequest_Chunk:
+		if v == nil {
+			err := ImportProductsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofPayloadPresent {
+		err := ImportProductsRequestValidationError{
+			field:  "Payload",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportProductsRequestMultiError) AllErrors() []error { return m }
+
+// ImportProductsRequestValidationError is the validation error returned by
+// ImportProductsRequest.Validate if the designated constraints aren't met.
+type ImportProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportProductsRequestValidationError) ErrorName() string {
+	return "ImportProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportProductsRequestValidationError{}
+
+// Validate checks the field values on ImportJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportJobMultiError, or nil
+// if none found.
+func (m *ImportJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for DryRun
+
+	// no validation rules for Rows
+
+	// no validation rules for Imported
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJobValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for FinishedAt
+
+	if len(errors) > 0 {
+		return ImportJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobMultiError is an error wrapping multiple validation errors returned
+// by ImportJob.ValidateAll() if the designated constraints aren't met.
+type ImportJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobMultiError) AllErrors() []error { return m }
+
+// ImportJobValidationError is the validation error returned by
+// ImportJob.Validate if the designated constraints aren't met.
+type ImportJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobValidationError) ErrorName() string { return "ImportJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobValidationError{}
+
+// Validate checks the field values on GetImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetImportJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetImportJobRequestMultiError, or nil if none found.
+func (m *GetImportJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetImportJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetImportJobRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetImportJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetImportJobRequest) _validateUuid(uuid string) error {
+	if matched := _products_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetImportJobRequestMultiError is an error wrapping multiple validation
+// errors returned by GetImportJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetImportJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetImportJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetImportJobRequestMultiError) AllErrors() []error { return m }
+
+// GetImportJobRequestValidationError is the validation error returned by
+// GetImportJobRequest.Validate if the designated constraints aren't met.
+type GetImportJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetImportJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetImportJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetImportJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetImportJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetImportJobRequestValidationError) ErrorName() string {
+	return "GetImportJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetImportJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetImportJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetImportJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetImportJobRequestValidationError{}
+
+// Validate checks the field values on ExportProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportProductsRequestMultiError, or nil if none found.
+func (m *ExportProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Category != nil {
+		// no validation rules for Category
+	}
+
+	if m.Tag != nil {
+		// no validation rules for Tag
+	}
+
+	if m.MinPrice != nil {
+
+		if m.GetMinPrice() < 0 {
+			err := ExportProductsRequestValidationError{
+				field:  "MinPrice",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MaxPrice != nil {
+
+		if m.GetMaxPrice() < 0 {
+			err := ExportProductsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportProductsRequestMultiError) AllErrors() []error { return m }
+
+// ExportProductsRequestValidationError is the validation error returned by
+// ExportProductsRequest.Validate if the designated constraints aren't met.
+type ExportProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportProductsRequestValidationError) ErrorName() string {
+	return "ExportProductsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProductsResponseValidationError) Error() string {
+func (e ExportProductsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1023,14 +4393,14 @@ func (e ListProductsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProductsResponse.%s: %s%s",
+		"invalid %sExportProductsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProductsResponseValidationError{}
+var _ error = ExportProductsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1038,70 +4408,290 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProductsResponseValidationError{}
+} = ExportProductsRequestValidationError{}
 
-// Validate checks the field values on UpdateProductRequest with the rules
+// Validate checks the field values on WatchProductsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProductRequest) Validate() error {
+func (m *WatchProductsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProductRequest with the rules
+// ValidateAll checks the field values on WatchProductsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProductRequestMultiError, or nil if none found.
-func (m *UpdateProductRequest) ValidateAll() error {
+// WatchProductsRequestMultiError, or nil if none found.
+func (m *WatchProductsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProductRequest) validate(all bool) error {
+func (m *WatchProductsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ResumeToken
 
-	// no validation rules for Attributes
+	if len(errors) > 0 {
+		return WatchProductsRequestMultiError(errors)
+	}
 
-	if m.Name != nil {
+	return nil
+}
 
-		if utf8.RuneCountInString(m.GetName()) < 3 {
-			err := UpdateProductRequestValidationError{
-				field:  "Name",
-				reason: "value length must be at least 3 runes",
+// WatchProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchProductsRequestMultiError) AllErrors() []error { return m }
+
+// WatchProductsRequestValidationError is the validation error returned by
+// WatchProductsRequest.Validate if the designated constraints aren't met.
+type WatchProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchProductsRequestValidationError) ErrorName() string {
+	return "WatchProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchProductsRequestValidationError{}
+
+// Validate checks the field values on ProductChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProductChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProductChangeMultiError, or
+// nil if none found.
+func (m *ProductChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductChangeValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductChangeValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductChangeValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
+
+	// no validation rules for Time
+
+	// no validation rules for ResumeToken
 
+	if len(errors) > 0 {
+		return ProductChangeMultiError(errors)
 	}
 
-	if m.Description != nil {
+	return nil
+}
 
-		if utf8.RuneCountInString(m.GetDescription()) < 3 {
-			err := UpdateProductRequestValidationError{
-				field:  "Description",
-				reason: "value length must be at least 3 runes",
+// ProductChangeMultiError is an error wrapping multiple validation errors
+// returned by ProductChange.ValidateAll() if the designated constraints
+// aren't met.
+type ProductChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductChangeMultiError) AllErrors() []error { return m }
+
+// ProductChangeValidationError is the validation error returned by
+// ProductChange.Validate if the designated constraints aren't met.
+type ProductChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductChangeValidationError) ErrorName() string { return "ProductChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductChangeValidationError{}
+
+// Validate checks the field values on BatchCreateProductsResponse_Result with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchCreateProductsResponse_Result) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateProductsResponse_Result
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BatchCreateProductsResponse_ResultMultiError, or nil if none found.
+func (m *BatchCreateProductsResponse_Result) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateProductsResponse_Result) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Outcome.(type) {
+	case *BatchCreateProductsResponse_Result_Id:
+		if v == nil {
+			err := BatchCreateProductsResponse_ResultValidationError{
+				field:  "Outcome",
+				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
 		}
-
-	}
-
-	if m.Price != nil {
-
-		if m.GetPrice() <= 0 {
-			err := UpdateProductRequestValidationError{
-				field:  "Price",
-				reason: "value must be greater than 0",
+		// no validation rules for Id
+	case *BatchCreateProductsResponse_Result_Error:
+		if v == nil {
+			err := BatchCreateProductsResponse_ResultValidationError{
+				field:  "Outcome",
+				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
 				return err
@@ -1109,30 +4699,54 @@ func (m *UpdateProductRequest) validate(all bool) error {
 			errors = append(errors, err)
 		}
 
-	}
-
-	if m.Category != nil {
-		// no validation rules for Category
-	}
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateProductsResponse_ResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateProductsResponse_ResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateProductsResponse_ResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.Thumbnail != nil {
-		// no validation rules for Thumbnail
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return UpdateProductRequestMultiError(errors)
+		return BatchCreateProductsResponse_ResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProductRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProductRequest.ValidateAll() if the designated
+// BatchCreateProductsResponse_ResultMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchCreateProductsResponse_Result.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProductRequestMultiError []error
+type BatchCreateProductsResponse_ResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProductRequestMultiError) Error() string {
+func (m BatchCreateProductsResponse_ResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1141,11 +4755,12 @@ func (m UpdateProductRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProductRequestMultiError) AllErrors() []error { return m }
+func (m BatchCreateProductsResponse_ResultMultiError) AllErrors() []error { return m }
 
-// UpdateProductRequestValidationError is the validation error returned by
-// UpdateProductRequest.Validate if the designated constraints aren't met.
-type UpdateProductRequestValidationError struct {
+// BatchCreateProductsResponse_ResultValidationError is the validation error
+// returned by BatchCreateProductsResponse_Result.Validate if the designated
+// constraints aren't met.
+type BatchCreateProductsResponse_ResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1153,24 +4768,24 @@ type UpdateProductRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProductRequestValidationError) Field() string { return e.field }
+func (e BatchCreateProductsResponse_ResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProductRequestValidationError) Reason() string { return e.reason }
+func (e BatchCreateProductsResponse_ResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProductRequestValidationError) Cause() error { return e.cause }
+func (e BatchCreateProductsResponse_ResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProductRequestValidationError) Key() bool { return e.key }
+func (e BatchCreateProductsResponse_ResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProductRequestValidationError) ErrorName() string {
-	return "UpdateProductRequestValidationError"
+func (e BatchCreateProductsResponse_ResultValidationError) ErrorName() string {
+	return "BatchCreateProductsResponse_ResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProductRequestValidationError) Error() string {
+func (e BatchCreateProductsResponse_ResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1182,14 +4797,14 @@ func (e UpdateProductRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProductRequest.%s: %s%s",
+		"invalid %sBatchCreateProductsResponse_Result.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProductRequestValidationError{}
+var _ error = BatchCreateProductsResponse_ResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1197,24 +4812,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProductRequestValidationError{}
+} = BatchCreateProductsResponse_ResultValidationError{}
 
-// Validate checks the field values on UpdateProductResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProductResponse) Validate() error {
+// Validate checks the field values on BatchDeleteProductsResponse_Result with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchDeleteProductsResponse_Result) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProductResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateProductResponseMultiError, or nil if none found.
-func (m *UpdateProductResponse) ValidateAll() error {
+// ValidateAll checks the field values on BatchDeleteProductsResponse_Result
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BatchDeleteProductsResponse_ResultMultiError, or nil if none found.
+func (m *BatchDeleteProductsResponse_Result) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProductResponse) validate(all bool) error {
+func (m *BatchDeleteProductsResponse_Result) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1223,20 +4839,50 @@ func (m *UpdateProductResponse) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchDeleteProductsResponse_ResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchDeleteProductsResponse_ResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchDeleteProductsResponse_ResultValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return UpdateProductResponseMultiError(errors)
+		return BatchDeleteProductsResponse_ResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProductResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateProductResponse.ValidateAll() if the designated
+// BatchDeleteProductsResponse_ResultMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchDeleteProductsResponse_Result.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProductResponseMultiError []error
+type BatchDeleteProductsResponse_ResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProductResponseMultiError) Error() string {
+func (m BatchDeleteProductsResponse_ResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1245,11 +4891,12 @@ func (m UpdateProductResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProductResponseMultiError) AllErrors() []error { return m }
+func (m BatchDeleteProductsResponse_ResultMultiError) AllErrors() []error { return m }
 
-// UpdateProductResponseValidationError is the validation error returned by
-// UpdateProductResponse.Validate if the designated constraints aren't met.
-type UpdateProductResponseValidationError struct {
+// BatchDeleteProductsResponse_ResultValidationError is the validation error
+// returned by BatchDeleteProductsResponse_Result.Validate if the designated
+// constraints aren't met.
+type BatchDeleteProductsResponse_ResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1257,24 +4904,24 @@ type UpdateProductResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProductResponseValidationError) Field() string { return e.field }
+func (e BatchDeleteProductsResponse_ResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProductResponseValidationError) Reason() string { return e.reason }
+func (e BatchDeleteProductsResponse_ResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProductResponseValidationError) Cause() error { return e.cause }
+func (e BatchDeleteProductsResponse_ResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProductResponseValidationError) Key() bool { return e.key }
+func (e BatchDeleteProductsResponse_ResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProductResponseValidationError) ErrorName() string {
-	return "UpdateProductResponseValidationError"
+func (e BatchDeleteProductsResponse_ResultValidationError) ErrorName() string {
+	return "BatchDeleteProductsResponse_ResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProductResponseValidationError) Error() string {
+func (e BatchDeleteProductsResponse_ResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1286,14 +4933,14 @@ func (e UpdateProductResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProductResponse.%s: %s%s",
+		"invalid %sBatchDeleteProductsResponse_Result.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProductResponseValidationError{}
+var _ error = BatchDeleteProductsResponse_ResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1301,46 +4948,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProductResponseValidationError{}
+} = BatchDeleteProductsResponse_ResultValidationError{}
 
-// Validate checks the field values on DeleteProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProductRequest) Validate() error {
+// Validate checks the field values on Facets_Count with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Facets_Count) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteProductRequestMultiError, or nil if none found.
-func (m *DeleteProductRequest) ValidateAll() error {
+// ValidateAll checks the field values on Facets_Count with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Facets_CountMultiError, or
+// nil if none found.
+func (m *Facets_Count) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProductRequest) validate(all bool) error {
+func (m *Facets_Count) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Value
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return DeleteProductRequestMultiError(errors)
+		return Facets_CountMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProductRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProductRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProductRequestMultiError []error
+// Facets_CountMultiError is an error wrapping multiple validation errors
+// returned by Facets_Count.ValidateAll() if the designated constraints aren't met.
+type Facets_CountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProductRequestMultiError) Error() string {
+func (m Facets_CountMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1349,11 +4997,11 @@ func (m DeleteProductRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+func (m Facets_CountMultiError) AllErrors() []error { return m }
 
-// DeleteProductRequestValidationError is the validation error returned by
-// DeleteProductRequest.Validate if the designated constraints aren't met.
-type DeleteProductRequestValidationError struct {
+// Facets_CountValidationError is the validation error returned by
+// Facets_Count.Validate if the designated constraints aren't met.
+type Facets_CountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1361,24 +5009,22 @@ type DeleteProductRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProductRequestValidationError) Field() string { return e.field }
+func (e Facets_CountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+func (e Facets_CountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+func (e Facets_CountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+func (e Facets_CountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProductRequestValidationError) ErrorName() string {
-	return "DeleteProductRequestValidationError"
-}
+func (e Facets_CountValidationError) ErrorName() string { return "Facets_CountValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteProductRequestValidationError) Error() string {
+func (e Facets_CountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1390,14 +5036,14 @@ func (e DeleteProductRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProductRequest.%s: %s%s",
+		"invalid %sFacets_Count.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProductRequestValidationError{}
+var _ error = Facets_CountValidationError{}
 
 var _ interface {
 	Field() string
@@ -1405,46 +5051,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProductRequestValidationError{}
+} = Facets_CountValidationError{}
 
-// Validate checks the field values on DeleteProductResponse with the rules
+// Validate checks the field values on Facets_PriceBucket with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProductResponse) Validate() error {
+func (m *Facets_PriceBucket) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProductResponse with the rules
+// ValidateAll checks the field values on Facets_PriceBucket with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProductResponseMultiError, or nil if none found.
-func (m *DeleteProductResponse) ValidateAll() error {
+// Facets_PriceBucketMultiError, or nil if none found.
+func (m *Facets_PriceBucket) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProductResponse) validate(all bool) error {
+func (m *Facets_PriceBucket) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Min
+
+	// no validation rules for Count
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
 
 	if len(errors) > 0 {
-		return DeleteProductResponseMultiError(errors)
+		return Facets_PriceBucketMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProductResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteProductResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProductResponseMultiError []error
+// Facets_PriceBucketMultiError is an error wrapping multiple validation errors
+// returned by Facets_PriceBucket.ValidateAll() if the designated constraints
+// aren't met.
+type Facets_PriceBucketMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProductResponseMultiError) Error() string {
+func (m Facets_PriceBucketMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1453,11 +5105,11 @@ func (m DeleteProductResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProductResponseMultiError) AllErrors() []error { return m }
+func (m Facets_PriceBucketMultiError) AllErrors() []error { return m }
 
-// DeleteProductResponseValidationError is the validation error returned by
-// DeleteProductResponse.Validate if the designated constraints aren't met.
-type DeleteProductResponseValidationError struct {
+// Facets_PriceBucketValidationError is the validation error returned by
+// Facets_PriceBucket.Validate if the designated constraints aren't met.
+type Facets_PriceBucketValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1465,24 +5117,24 @@ type DeleteProductResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProductResponseValidationError) Field() string { return e.field }
+func (e Facets_PriceBucketValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProductResponseValidationError) Reason() string { return e.reason }
+func (e Facets_PriceBucketValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProductResponseValidationError) Cause() error { return e.cause }
+func (e Facets_PriceBucketValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProductResponseValidationError) Key() bool { return e.key }
+func (e Facets_PriceBucketValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProductResponseValidationError) ErrorName() string {
-	return "DeleteProductResponseValidationError"
+func (e Facets_PriceBucketValidationError) ErrorName() string {
+	return "Facets_PriceBucketValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProductResponseValidationError) Error() string {
+func (e Facets_PriceBucketValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1494,14 +5146,14 @@ func (e DeleteProductResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProductResponse.%s: %s%s",
+		"invalid %sFacets_PriceBucket.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProductResponseValidationError{}
+var _ error = Facets_PriceBucketValidationError{}
 
 var _ interface {
 	Field() string
@@ -1509,57 +5161,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProductResponseValidationError{}
+} = Facets_PriceBucketValidationError{}
 
-// Validate checks the field values on SearchProductsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchProductsRequest) Validate() error {
+// Validate checks the field values on Facets_Attribute with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Facets_Attribute) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchProductsRequest with the rules
+// ValidateAll checks the field values on Facets_Attribute with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchProductsRequestMultiError, or nil if none found.
-func (m *SearchProductsRequest) ValidateAll() error {
+// Facets_AttributeMultiError, or nil if none found.
+func (m *Facets_Attribute) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchProductsRequest) validate(all bool) error {
+func (m *Facets_Attribute) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Query
+	// no validation rules for Key
 
-	if m.Pagination != nil {
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetPagination()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchProductsRequestValidationError{
-						field:  "Pagination",
+					errors = append(errors, Facets_AttributeValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchProductsRequestValidationError{
-						field:  "Pagination",
+					errors = append(errors, Facets_AttributeValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SearchProductsRequestValidationError{
-					field:  "Pagination",
+				return Facets_AttributeValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1569,19 +5222,19 @@ func (m *SearchProductsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SearchProductsRequestMultiError(errors)
+		return Facets_AttributeMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchProductsRequestMultiError is an error wrapping multiple validation
-// errors returned by SearchProductsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SearchProductsRequestMultiError []error
+// Facets_AttributeMultiError is an error wrapping multiple validation errors
+// returned by Facets_Attribute.ValidateAll() if the designated constraints
+// aren't met.
+type Facets_AttributeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchProductsRequestMultiError) Error() string {
+func (m Facets_AttributeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1590,11 +5243,11 @@ func (m SearchProductsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchProductsRequestMultiError) AllErrors() []error { return m }
+func (m Facets_AttributeMultiError) AllErrors() []error { return m }
 
-// SearchProductsRequestValidationError is the validation error returned by
-// SearchProductsRequest.Validate if the designated constraints aren't met.
-type SearchProductsRequestValidationError struct {
+// Facets_AttributeValidationError is the validation error returned by
+// Facets_Attribute.Validate if the designated constraints aren't met.
+type Facets_AttributeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1602,24 +5255,22 @@ type SearchProductsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchProductsRequestValidationError) Field() string { return e.field }
+func (e Facets_AttributeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchProductsRequestValidationError) Reason() string { return e.reason }
+func (e Facets_AttributeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchProductsRequestValidationError) Cause() error { return e.cause }
+func (e Facets_AttributeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchProductsRequestValidationError) Key() bool { return e.key }
+func (e Facets_AttributeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchProductsRequestValidationError) ErrorName() string {
-	return "SearchProductsRequestValidationError"
-}
+func (e Facets_AttributeValidationError) ErrorName() string { return "Facets_AttributeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SearchProductsRequestValidationError) Error() string {
+func (e Facets_AttributeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1631,14 +5282,14 @@ func (e SearchProductsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchProductsRequest.%s: %s%s",
+		"invalid %sFacets_Attribute.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchProductsRequestValidationError{}
+var _ error = Facets_AttributeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1646,107 +5297,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchProductsRequestValidationError{}
+} = Facets_AttributeValidationError{}
 
-// Validate checks the field values on SearchProductsResponse with the rules
+// Validate checks the field values on ImportJob_RowError with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchProductsResponse) Validate() error {
+func (m *ImportJob_RowError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchProductsResponse with the rules
+// ValidateAll checks the field values on ImportJob_RowError with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchProductsResponseMultiError, or nil if none found.
-func (m *SearchProductsResponse) ValidateAll() error {
+// ImportJob_RowErrorMultiError, or nil if none found.
+func (m *ImportJob_RowError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchProductsResponse) validate(all bool) error {
+func (m *ImportJob_RowError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProducts() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchProductsResponseValidationError{
-						field:  fmt.Sprintf("Products[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchProductsResponseValidationError{
-						field:  fmt.Sprintf("Products[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SearchProductsResponseValidationError{
-					field:  fmt.Sprintf("Products[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Line
 
-	}
+	// no validation rules for Field
 
-	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SearchProductsResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SearchProductsResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SearchProductsResponseValidationError{
-				field:  "Pagination",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return SearchProductsResponseMultiError(errors)
+		return ImportJob_RowErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchProductsResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchProductsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SearchProductsResponseMultiError []error
+// ImportJob_RowErrorMultiError is an error wrapping multiple validation errors
+// returned by ImportJob_RowError.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJob_RowErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchProductsResponseMultiError) Error() string {
+func (m ImportJob_RowErrorMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1755,11 +5349,11 @@ func (m SearchProductsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchProductsResponseMultiError) AllErrors() []error { return m }
+func (m ImportJob_RowErrorMultiError) AllErrors() []error { return m }
 
-// SearchProductsResponseValidationError is the validation error returned by
-// SearchProductsResponse.Validate if the designated constraints aren't met.
-type SearchProductsResponseValidationError struct {
+// ImportJob_RowErrorValidationError is the validation error returned by
+// ImportJob_RowError.Validate if the designated constraints aren't met.
+type ImportJob_RowErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1767,24 +5361,24 @@ type SearchProductsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchProductsResponseValidationError) Field() string { return e.field }
+func (e ImportJob_RowErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchProductsResponseValidationError) Reason() string { return e.reason }
+func (e ImportJob_RowErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchProductsResponseValidationError) Cause() error { return e.cause }
+func (e ImportJob_RowErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchProductsResponseValidationError) Key() bool { return e.key }
+func (e ImportJob_RowErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchProductsResponseValidationError) ErrorName() string {
-	return "SearchProductsResponseValidationError"
+func (e ImportJob_RowErrorValidationError) ErrorName() string {
+	return "ImportJob_RowErrorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchProductsResponseValidationError) Error() string {
+func (e ImportJob_RowErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1796,14 +5390,14 @@ func (e SearchProductsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchProductsResponse.%s: %s%s",
+		"invalid %sImportJob_RowError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchProductsResponseValidationError{}
+var _ error = ImportJob_RowErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -1811,4 +5405,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchProductsResponseValidationError{}
+} = ImportJob_RowErrorValidationError{}
@@ -19,30 +19,46 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationProductsBatchCreateProducts = "/products.v1.Products/BatchCreateProducts"
+const OperationProductsBatchDeleteProducts = "/products.v1.Products/BatchDeleteProducts"
 const OperationProductsCreateProduct = "/products.v1.Products/CreateProduct"
 const OperationProductsDeleteProduct = "/products.v1.Products/DeleteProduct"
+const OperationProductsGetImportJob = "/products.v1.Products/GetImportJob"
 const OperationProductsGetProduct = "/products.v1.Products/GetProduct"
 const OperationProductsListProducts = "/products.v1.Products/ListProducts"
 const OperationProductsSearchProducts = "/products.v1.Products/SearchProducts"
+const OperationProductsSuggestProducts = "/products.v1.Products/SuggestProducts"
 const OperationProductsUpdateProduct = "/products.v1.Products/UpdateProduct"
 
 type ProductsHTTPServer interface {
+	// BatchCreateProducts BatchCreateProducts inserts up to server.batch.max_size products at once.
+	BatchCreateProducts(context.Context, *BatchCreateProductsRequest) (*BatchCreateProductsResponse, error)
+	// BatchDeleteProducts BatchDeleteProducts deletes up to server.batch.max_size products at once.
+	BatchDeleteProducts(context.Context, *BatchDeleteProductsRequest) (*BatchDeleteProductsResponse, error)
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	// SuggestProducts SuggestProducts completes a prefix with product names and tags, the
+	// most searched first, for typeahead.
+	SuggestProducts(context.Context, *SuggestProductsRequest) (*SuggestProductsResponse, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
 }
 
 func RegisterProductsHTTPServer(s *http.Server, srv ProductsHTTPServer) {
 	r := s.Route("/")
 	r.POST("/products", _Products_CreateProduct0_HTTP_Handler(srv))
+	r.POST("/products:batchCreate", _Products_BatchCreateProducts0_HTTP_Handler(srv))
+	r.POST("/products:batchDelete", _Products_BatchDeleteProducts0_HTTP_Handler(srv))
 	r.GET("/products/{id}", _Products_GetProduct0_HTTP_Handler(srv))
 	r.GET("/products", _Products_ListProducts0_HTTP_Handler(srv))
 	r.PATCH("/products/{id}", _Products_UpdateProduct0_HTTP_Handler(srv))
 	r.DELETE("/products/{id}", _Products_DeleteProduct0_HTTP_Handler(srv))
 	r.GET("/products/search", _Products_SearchProducts0_HTTP_Handler(srv))
+	r.GET("/products:suggest", _Products_SuggestProducts0_HTTP_Handler(srv))
+	r.GET("/products/imports/{id}", _Products_GetImportJob0_HTTP_Handler(srv))
 }
 
 func _Products_CreateProduct0_HTTP_Handler(srv ProductsHTTPServer) func(ctx http.Context) error {
@@ -67,6 +83,50 @@ func _Products_CreateProduct0_HTTP_Handler(srv ProductsHTTPServer) func(ctx http
 	}
 }
 
+func _Products_BatchCreateProducts0_HTTP_Handler(srv ProductsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchCreateProductsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductsBatchCreateProducts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchCreateProducts(ctx, req.(*BatchCreateProductsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchCreateProductsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Products_BatchDeleteProducts0_HTTP_Handler(srv ProductsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchDeleteProductsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductsBatchDeleteProducts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchDeleteProducts(ctx, req.(*BatchDeleteProductsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchDeleteProductsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Products_GetProduct0_HTTP_Handler(srv ProductsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetProductRequest
@@ -174,12 +234,61 @@ func _Products_SearchProducts0_HTTP_Handler(srv ProductsHTTPServer) func(ctx htt
 	}
 }
 
+func _Products_SuggestProducts0_HTTP_Handler(srv ProductsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestProductsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductsSuggestProducts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuggestProducts(ctx, req.(*SuggestProductsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuggestProductsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Products_GetImportJob0_HTTP_Handler(srv ProductsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetImportJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductsGetImportJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetImportJob(ctx, req.(*GetImportJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportJob)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ProductsHTTPClient interface {
+	// BatchCreateProducts BatchCreateProducts inserts up to server.batch.max_size products at once.
+	BatchCreateProducts(ctx context.Context, req *BatchCreateProductsRequest, opts ...http.CallOption) (rsp *BatchCreateProductsResponse, err error)
+	// BatchDeleteProducts BatchDeleteProducts deletes up to server.batch.max_size products at once.
+	BatchDeleteProducts(ctx context.Context, req *BatchDeleteProductsRequest, opts ...http.CallOption) (rsp *BatchDeleteProductsResponse, err error)
 	CreateProduct(ctx context.Context, req *CreateProductRequest, opts ...http.CallOption) (rsp *CreateProductResponse, err error)
 	DeleteProduct(ctx context.Context, req *DeleteProductRequest, opts ...http.CallOption) (rsp *DeleteProductResponse, err error)
+	GetImportJob(ctx context.Context, req *GetImportJobRequest, opts ...http.CallOption) (rsp *ImportJob, err error)
 	GetProduct(ctx context.Context, req *GetProductRequest, opts ...http.CallOption) (rsp *GetProductResponse, err error)
 	ListProducts(ctx context.Context, req *ListProductsRequest, opts ...http.CallOption) (rsp *ListProductsResponse, err error)
 	SearchProducts(ctx context.Context, req *SearchProductsRequest, opts ...http.CallOption) (rsp *SearchProductsResponse, err error)
+	// SuggestProducts SuggestProducts completes a prefix with product names and tags, the
+	// most searched first, for typeahead.
+	SuggestProducts(ctx context.Context, req *SuggestProductsRequest, opts ...http.CallOption) (rsp *SuggestProductsResponse, err error)
 	UpdateProduct(ctx context.Context, req *UpdateProductRequest, opts ...http.CallOption) (rsp *UpdateProductResponse, err error)
 }
 
@@ -191,6 +300,34 @@ func NewProductsHTTPClient(client *http.Client) ProductsHTTPClient {
 	return &ProductsHTTPClientImpl{client}
 }
 
+// BatchCreateProducts BatchCreateProducts inserts up to server.batch.max_size products at once.
+func (c *ProductsHTTPClientImpl) BatchCreateProducts(ctx context.Context, in *BatchCreateProductsRequest, opts ...http.CallOption) (*BatchCreateProductsResponse, error) {
+	var out BatchCreateProductsResponse
+	pattern := "/products:batchCreate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductsBatchCreateProducts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchDeleteProducts BatchDeleteProducts deletes up to server.batch.max_size products at once.
+func (c *ProductsHTTPClientImpl) BatchDeleteProducts(ctx context.Context, in *BatchDeleteProductsRequest, opts ...http.CallOption) (*BatchDeleteProductsResponse, error) {
+	var out BatchDeleteProductsResponse
+	pattern := "/products:batchDelete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductsBatchDeleteProducts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ProductsHTTPClientImpl) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...http.CallOption) (*CreateProductResponse, error) {
 	var out CreateProductResponse
 	pattern := "/products"
@@ -217,6 +354,19 @@ func (c *ProductsHTTPClientImpl) DeleteProduct(ctx context.Context, in *DeletePr
 	return &out, nil
 }
 
+func (c *ProductsHTTPClientImpl) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...http.CallOption) (*ImportJob, error) {
+	var out ImportJob
+	pattern := "/products/imports/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductsGetImportJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ProductsHTTPClientImpl) GetProduct(ctx context.Context, in *GetProductRequest, opts ...http.CallOption) (*GetProductResponse, error) {
 	var out GetProductResponse
 	pattern := "/products/{id}"
@@ -256,6 +406,21 @@ func (c *ProductsHTTPClientImpl) SearchProducts(ctx context.Context, in *SearchP
 	return &out, nil
 }
 
+// SuggestProducts SuggestProducts completes a prefix with product names and tags, the
+// most searched first, for typeahead.
+func (c *ProductsHTTPClientImpl) SuggestProducts(ctx context.Context, in *SuggestProductsRequest, opts ...http.CallOption) (*SuggestProductsResponse, error) {
+	var out SuggestProductsResponse
+	pattern := "/products:suggest"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductsSuggestProducts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ProductsHTTPClientImpl) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...http.CallOption) (*UpdateProductResponse, error) {
 	var out UpdateProductResponse
 	pattern := "/products/{id}"
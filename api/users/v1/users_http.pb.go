@@ -19,6 +19,8 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUsersBatchGetUsers = "/users.v1.Users/BatchGetUsers"
+const OperationUsersCheckAvailability = "/users.v1.Users/CheckAvailability"
 const OperationUsersCreateUser = "/users.v1.Users/CreateUser"
 const OperationUsersDeleteUser = "/users.v1.Users/DeleteUser"
 const OperationUsersGetUser = "/users.v1.Users/GetUser"
@@ -27,6 +29,11 @@ const OperationUsersSearchUsers = "/users.v1.Users/SearchUsers"
 const OperationUsersUpdateUser = "/users.v1.Users/UpdateUser"
 
 type UsersHTTPServer interface {
+	// BatchGetUsers BatchGetUsers fetches up to server.batch.max_size users in one query.
+	BatchGetUsers(context.Context, *BatchGetUsersRequest) (*BatchGetUsersResponse, error)
+	// CheckAvailability CheckAvailability tells whether a username, email or phone is still free,
+	// so signup forms can warn before CreateUser fails with USER_ALREADY_EXISTS.
+	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
@@ -38,6 +45,8 @@ type UsersHTTPServer interface {
 func RegisterUsersHTTPServer(s *http.Server, srv UsersHTTPServer) {
 	r := s.Route("/")
 	r.POST("/users", _Users_CreateUser0_HTTP_Handler(srv))
+	r.GET("/users/availability", _Users_CheckAvailability0_HTTP_Handler(srv))
+	r.GET("/users:batchGet", _Users_BatchGetUsers0_HTTP_Handler(srv))
 	r.GET("/users/{id}", _Users_GetUser0_HTTP_Handler(srv))
 	r.GET("/users", _Users_ListUsers0_HTTP_Handler(srv))
 	r.PATCH("/users/{id}", _Users_UpdateUser0_HTTP_Handler(srv))
@@ -67,6 +76,44 @@ func _Users_CreateUser0_HTTP_Handler(srv UsersHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _Users_CheckAvailability0_HTTP_Handler(srv UsersHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckAvailabilityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUsersCheckAvailability)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckAvailability(ctx, req.(*CheckAvailabilityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckAvailabilityResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Users_BatchGetUsers0_HTTP_Handler(srv UsersHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUsersBatchGetUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetUsers(ctx, req.(*BatchGetUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetUsersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Users_GetUser0_HTTP_Handler(srv UsersHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetUserRequest
@@ -175,6 +222,11 @@ func _Users_SearchUsers0_HTTP_Handler(srv UsersHTTPServer) func(ctx http.Context
 }
 
 type UsersHTTPClient interface {
+	// BatchGetUsers BatchGetUsers fetches up to server.batch.max_size users in one query.
+	BatchGetUsers(ctx context.Context, req *BatchGetUsersRequest, opts ...http.CallOption) (rsp *BatchGetUsersResponse, err error)
+	// CheckAvailability CheckAvailability tells whether a username, email or phone is still free,
+	// so signup forms can warn before CreateUser fails with USER_ALREADY_EXISTS.
+	CheckAvailability(ctx context.Context, req *CheckAvailabilityRequest, opts ...http.CallOption) (rsp *CheckAvailabilityResponse, err error)
 	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserResponse, err error)
 	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserResponse, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserResponse, err error)
@@ -191,6 +243,35 @@ func NewUsersHTTPClient(client *http.Client) UsersHTTPClient {
 	return &UsersHTTPClientImpl{client}
 }
 
+// BatchGetUsers BatchGetUsers fetches up to server.batch.max_size users in one query.
+func (c *UsersHTTPClientImpl) BatchGetUsers(ctx context.Context, in *BatchGetUsersRequest, opts ...http.CallOption) (*BatchGetUsersResponse, error) {
+	var out BatchGetUsersResponse
+	pattern := "/users:batchGet"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUsersBatchGetUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckAvailability CheckAvailability tells whether a username, email or phone is still free,
+// so signup forms can warn before CreateUser fails with USER_ALREADY_EXISTS.
+func (c *UsersHTTPClientImpl) CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...http.CallOption) (*CheckAvailabilityResponse, error) {
+	var out CheckAvailabilityResponse
+	pattern := "/users/availability"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUsersCheckAvailability))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UsersHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...http.CallOption) (*CreateUserResponse, error) {
 	var out CreateUserResponse
 	pattern := "/users"
@@ -3,18 +3,17 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 
 	"layout/internal/conf"
-	"layout/internal/data"
-	"layout/pkg/datasource"
+	pkgconfig "layout/pkg/config"
 	"layout/pkg/monitor"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
-	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -34,8 +33,35 @@ var (
 	id, _ = os.Hostname()
 )
 
+// command is a subcommand of the server binary. Offline commands need no
+// infrastructure and log to stdout only, see newLogger.
+type command struct {
+	usage   string
+	run     func(ctx context.Context, bc *conf.Bootstrap, w *pkgconfig.Watcher, logger log.Logger, args []string) error
+	offline bool
+}
+
+var commands = map[string]command{
+	"serve":   {"serve                       run the gRPC and HTTP servers (default)", runServe, false},
+	"migrate": {"migrate up|down [n]|status  manage the postgres schema, `migrate indexes|suggestions` ensure mongo indexes or rebuild product suggestions", runMigrate, false},
+	"seed":    {"seed <file.json|file.yaml>  load fixture users and products", runSeed, false},
+	"config":  {"config validate|print       check or show the effective config, secrets masked", runConfig, true},
+	"routes":  {"routes                      list every HTTP and gRPC operation", runRoutes, true},
+}
+
+var commandOrder = []string{"serve", "migrate", "seed", "config", "routes"}
+
 func init() {
 	flag.StringVar(&flagconf, "conf", "./configs", "config path, eg: -conf config.yaml")
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintf(out, "Usage: %s [-conf path] <command> [args]\n\nCommands:\n", os.Args[0])
+		for _, name := range commandOrder {
+			fmt.Fprintf(out, "  %s\n", commands[name].usage)
+		}
+		fmt.Fprintln(out, "\nFlags:")
+		flag.PrintDefaults()
+	}
 }
 
 func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
@@ -52,29 +78,28 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 	)
 }
 
-func main() {
-	flag.Parse()
-
-	// load config
-	c := config.New(
-		config.WithSource(
-			file.NewSource(flagconf),
-		),
-	)
-	defer c.Close()
+// loadConfig reads, scans and validates the bootstrap config found at flagconf,
+// layered with the overlay of the configured environment and APP_* variables.
+func loadConfig() (config.Config, *conf.Bootstrap, error) {
+	return pkgconfig.LoadBootstrap(flagconf)
+}
 
-	if err := c.Load(); err != nil {
-		panic(err)
+// newLogger builds the configured logger, or a plain stdout one for offline
+// commands so that they do not set up log files nor OTLP exporters.
+func newLogger(ctx context.Context, bc *conf.Bootstrap, offline bool) (log.Logger, func(), error) {
+	if offline {
+		lg := log.NewFilter(log.NewStdLogger(os.Stdout), log.FilterLevel(log.ParseLevel(bc.GetLog().GetLevel())))
+		return withService(lg), func() {}, nil
 	}
-
-	var bc conf.Bootstrap
-	if err := c.Scan(&bc); err != nil {
-		panic(err)
+	lg, cleanup, err := monitor.NewLogger(ctx, bc)
+	if err != nil {
+		return nil, nil, err
 	}
+	return withService(lg), cleanup, nil
+}
 
-	// init logger
-	lg := monitor.NewLogger(&bc)
-	logger := log.With(lg,
+func withService(lg log.Logger) log.Logger {
+	return log.With(lg,
 		"caller", log.DefaultCaller,
 		"service.id", id,
 		"service.name", Name,
@@ -82,21 +107,54 @@ func main() {
 		"trace.id", tracing.TraceID(),
 		"span.id", tracing.SpanID(),
 	)
+}
+
+func main() {
+	flag.Parse()
+
+	name, args := "serve", flag.Args()
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+	if name == "help" {
+		flag.Usage()
+		return
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(cmd, args); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", name, err)
+		os.Exit(1)
+	}
+}
+
+func run(cmd command, args []string) error {
+	c, bc, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	log.NewHelper(logger).Debug("Wiring app")
-	app, cleanup, err := wireApp(ctx, &bc, bc.Server, bc.Data, logger)
+	logger, cleanup, err := newLogger(ctx, bc, cmd.offline)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	defer cleanup()
 
-	datasource.GormMigrate(ctx, bc.Data, logger, &data.Users{})
+	w := pkgconfig.NewWatcher(flagconf, c, bc, logger)
+	w.OnLogLevel(func(level string) {
+		if err := monitor.SetLevel(level); err != nil {
+			log.NewHelper(logger).Errorf("CONFIG: invalid log level %q: %s", level, err)
+		}
+	})
 
-	log.NewHelper(logger).Debug("Starting Server")
-	if err := app.Run(); err != nil {
-		panic(err)
-	}
+	return cmd.run(ctx, bc, w, logger, args)
 }
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"layout/internal/conf"
+	"layout/internal/data"
+	pkgconfig "layout/pkg/config"
+	"layout/pkg/datasource"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+)
+
+// newMigrator returns a migrator on its own database pool, which the cleanup
+// closes.
+func newMigrator(c *conf.Data, logger log.Logger) (datasource.Migrator, func(), error) {
+	g, err := datasource.NewGorm(c, logger, otel.GetTracerProvider())
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := datasource.NewMigrator(g.GetDB(), logger, data.Migrations())
+	if err != nil {
+		g.GetCleanup()()
+		return nil, nil, err
+	}
+	return m, g.GetCleanup(), nil
+}
+
+// ensureIndexes creates the declared mongo indexes and reports drifted ones.
+func ensureIndexes(ctx context.Context, c *conf.Data, logger log.Logger) error {
+	m, err := datasource.NewMongo(ctx, c, logger, otel.GetTracerProvider())
+	if err != nil {
+		return err
+	}
+	defer m.GetCleanup()()
+	return data.EnsureProductIndexes(ctx, m.GetDB(), logger)
+}
+
+// rebuildSuggestions indexes the names and tags of every product again.
+func rebuildSuggestions(ctx context.Context, c *conf.Data, logger log.Logger) error {
+	m, err := datasource.NewMongo(ctx, c, logger, otel.GetTracerProvider())
+	if err != nil {
+		return err
+	}
+	defer m.GetCleanup()()
+	r, err := datasource.NewRedis(c, logger, otel.GetTracerProvider())
+	if err != nil {
+		return err
+	}
+	defer r.GetCleanup()()
+	return data.RebuildProductSuggestions(ctx, m.GetDB(), r.GetClient(), logger)
+}
+
+// runMigrate implements `migrate up|down [steps]|status|indexes|suggestions`.
+func runMigrate(ctx context.Context, bc *conf.Bootstrap, _ *pkgconfig.Watcher, logger log.Logger, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up|down [steps]|status|indexes|suggestions")
+	}
+	switch args[0] {
+	case "indexes":
+		return ensureIndexes(ctx, bc.Data, logger)
+	case "suggestions":
+		return rebuildSuggestions(ctx, bc.Data, logger)
+	}
+	m, cleanup, err := newMigrator(bc.Data, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	switch args[0] {
+	case "up":
+		return m.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid step count %q", args[1])
+			}
+		}
+		return m.Down(ctx, steps)
+	case "status":
+		res, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range res {
+			at := "pending"
+			if s.Applied {
+				at = s.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, at)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+}
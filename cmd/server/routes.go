@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"layout/internal/conf"
+	"layout/internal/server"
+	"layout/internal/service"
+	pkgconfig "layout/pkg/config"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// runRoutes lists the operations exposed by the HTTP and gRPC servers. The
+// servers are built around empty services since registration only needs the
+// handler types, so this works without any backing infrastructure.
+func runRoutes(_ context.Context, bc *conf.Bootstrap, _ *pkgconfig.Watcher, logger log.Logger, _ []string) error {
+	meter := metricnoop.NewMeterProvider().Meter("routes")
+	tp := tracenoop.NewTracerProvider()
+	users, products := &service.UsersService{}, &service.ProductsService{}
+
+	hs, err := server.NewHTTPServer(bc.Server, nil, users, products, logger, meter, tp)
+	if err != nil {
+		return err
+	}
+	gs, err := server.NewGRPCServer(bc.Server, users, products, logger, meter, tp)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TRANSPORT\tMETHOD\tPATH")
+	err = hs.WalkRoute(func(r http.RouteInfo) error {
+		_, err := fmt.Fprintf(w, "http\t%s\t%s\n", r.Method, r.Path)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	info := gs.GetServiceInfo()
+	services := make([]string, 0, len(info))
+	for name := range info {
+		services = append(services, name)
+	}
+	sort.Strings(services)
+	for _, name := range services {
+		for _, m := range info[name].Methods {
+			kind := "unary"
+			if m.IsClientStream || m.IsServerStream {
+				kind = "stream"
+			}
+			fmt.Fprintf(w, "grpc\t%s\t/%s/%s\n", kind, name, m.Name)
+		}
+	}
+	return w.Flush()
+}
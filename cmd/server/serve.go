@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+
+	"layout/internal/conf"
+	pkgconfig "layout/pkg/config"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// runServe applies pending migrations and runs the application until it is stopped.
+func runServe(ctx context.Context, bc *conf.Bootstrap, w *pkgconfig.Watcher, logger log.Logger, _ []string) error {
+	migrator, cleanup, err := newMigrator(bc.Data, logger)
+	if err != nil {
+		return err
+	}
+	// the app opens its own pool, this one is only needed to migrate
+	err = migrator.Up(ctx)
+	cleanup()
+	if err != nil {
+		return err
+	}
+
+	log.NewHelper(logger).Debug("Wiring app")
+	app, cleanup, err := wireApp(ctx, bc, bc.Server, bc.Data, w, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// subscriptions are registered while wiring, watch once they are all in
+	if err := w.Watch(); err != nil {
+		return err
+	}
+
+	log.NewHelper(logger).Debug("Starting Server")
+	return app.Run()
+}
@@ -12,6 +12,7 @@ import (
 	"layout/internal/data"
 	"layout/internal/server"
 	"layout/internal/service"
+	"layout/pkg/config"
 	"layout/pkg/datasource"
 	"layout/pkg/monitor"
 
@@ -21,7 +22,7 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(context.Context, *conf.Bootstrap, *conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
+func wireApp(context.Context, *conf.Bootstrap, *conf.Server, *conf.Data, *config.Watcher, log.Logger) (*kratos.App, func(), error) {
 	panic(
 		wire.Build(
 			datasource.DatasourceProviderSet,
@@ -34,3 +35,17 @@ func wireApp(context.Context, *conf.Bootstrap, *conf.Server, *conf.Data, log.Log
 		),
 	)
 }
+
+// wireSeeder init the fixtures seeder.
+func wireSeeder(context.Context, *conf.Bootstrap, *conf.Server, *conf.Data, *config.Watcher, log.Logger) (*seeder, func(), error) {
+	panic(
+		wire.Build(
+			datasource.DatasourceProviderSet,
+			monitor.MonitorProviderSet,
+			data.DataProviderSet,
+			biz.BizProviderSet,
+			service.ServiceProviderSet,
+			newSeeder,
+		),
+	)
+}
@@ -15,6 +15,7 @@ import (
 	"layout/internal/data"
 	"layout/internal/server"
 	"layout/internal/service"
+	"layout/pkg/config"
 	"layout/pkg/datasource"
 	"layout/pkg/monitor"
 )
@@ -26,65 +27,171 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, confServer *conf.Server, confData *conf.Data, watcher *config.Watcher, logger log.Logger) (*kratos.App, func(), error) {
 	textMapPropagator := monitor.NewTextMapPropagator()
-	tracerProvider, err := monitor.NewTracerProvider(contextContext, bootstrap, textMapPropagator)
+	tracerProvider, cleanup, err := monitor.NewTracerProvider(contextContext, bootstrap, textMapPropagator)
 	if err != nil {
 		return nil, nil, err
 	}
 	gorm, err := datasource.NewGorm(confData, logger, tracerProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	mongo, err := datasource.NewMongo(contextContext, confData, logger, tracerProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	nats, err := datasource.NewNats(confData, logger, tracerProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	redis, err := datasource.NewRedis(confData, logger, tracerProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	dataData, err := data.NewData(confData, gorm, mongo, nats, redis, logger, tracerProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	tracer, err := monitor.NewTracer(bootstrap, tracerProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	usersRepo, err := data.NewUsersRepo(dataData, logger, tracer)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
-	usersUsecase := biz.NewUsersUsecase(usersRepo, logger)
-	usersService := service.NewUsersService(usersUsecase, logger)
-	productsRepo, err := data.NewProductsRepo(dataData, logger, tracer)
+	userEventsRepo := data.NewUserEventsRepo(dataData, logger, tracer)
+	meterProvider, cleanup2, err := monitor.NewMeterProvider(contextContext, bootstrap)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
-	productsUsecase := biz.NewProductsUsecase(productsRepo, logger)
-	productsService := service.NewProductsService(productsUsecase, logger)
-	meterProvider, err := monitor.NewMeterProvider(bootstrap)
+	meter, err := monitor.NewMeter(bootstrap, meterProvider)
 	if err != nil {
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
-	meter, err := monitor.NewMeter(bootstrap, meterProvider)
+	metrics, err := biz.NewMetrics(meter)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	usersUsecase := biz.NewUsersUsecase(usersRepo, userEventsRepo, metrics, logger, tracer)
+	usersService := service.NewUsersService(usersUsecase, confServer, logger, tracer)
+	productsRepo, err := data.NewProductsRepo(dataData, logger, tracer)
 	if err != nil {
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
+	importJobsRepo := data.NewImportJobsRepo(dataData, logger)
+	productSuggestionsRepo := data.NewProductSuggestionsRepo(dataData, logger)
+	productsUsecase := biz.NewProductsUsecase(productsRepo, importJobsRepo, productSuggestionsRepo, metrics, logger, tracer)
+	productsService := service.NewProductsService(productsUsecase, confServer, logger, tracer)
 	grpcServer, err := server.NewGRPCServer(confServer, usersService, productsService, logger, meter, tracerProvider)
 	if err != nil {
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
-	httpServer, err := server.NewHTTPServer(confServer, usersService, productsService, logger, meter, tracerProvider)
+	httpServer, err := server.NewHTTPServer(confServer, watcher, usersService, productsService, logger, meter, tracerProvider)
 	if err != nil {
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireSeeder init the fixtures seeder.
+func wireSeeder(contextContext context.Context, bootstrap *conf.Bootstrap, confServer *conf.Server, confData *conf.Data, watcher *config.Watcher, logger log.Logger) (*seeder, func(), error) {
+	textMapPropagator := monitor.NewTextMapPropagator()
+	tracerProvider, cleanup, err := monitor.NewTracerProvider(contextContext, bootstrap, textMapPropagator)
+	if err != nil {
+		return nil, nil, err
+	}
+	gorm, err := datasource.NewGorm(confData, logger, tracerProvider)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	mongo, err := datasource.NewMongo(contextContext, confData, logger, tracerProvider)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	nats, err := datasource.NewNats(confData, logger, tracerProvider)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	redis, err := datasource.NewRedis(confData, logger, tracerProvider)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dataData, err := data.NewData(confData, gorm, mongo, nats, redis, logger, tracerProvider)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	tracer, err := monitor.NewTracer(bootstrap, tracerProvider)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	usersRepo, err := data.NewUsersRepo(dataData, logger, tracer)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userEventsRepo := data.NewUserEventsRepo(dataData, logger, tracer)
+	meterProvider, cleanup2, err := monitor.NewMeterProvider(contextContext, bootstrap)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	meter, err := monitor.NewMeter(bootstrap, meterProvider)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	metrics, err := biz.NewMetrics(meter)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	usersUsecase := biz.NewUsersUsecase(usersRepo, userEventsRepo, metrics, logger, tracer)
+	usersService := service.NewUsersService(usersUsecase, confServer, logger, tracer)
+	productsRepo, err := data.NewProductsRepo(dataData, logger, tracer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	importJobsRepo := data.NewImportJobsRepo(dataData, logger)
+	productSuggestionsRepo := data.NewProductSuggestionsRepo(dataData, logger)
+	productsUsecase := biz.NewProductsUsecase(productsRepo, importJobsRepo, productSuggestionsRepo, metrics, logger, tracer)
+	productsService := service.NewProductsService(productsUsecase, confServer, logger, tracer)
+	mainSeeder := newSeeder(usersService, productsService, logger)
+	return mainSeeder, func() {
+		cleanup2()
+		cleanup()
 	}, nil
 }
@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"layout/pkg/redact"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Attribute keys shared by the business instruments and the spans of every
+// layer. Metric attributes stay low cardinality: domain is "users" or
+// "products".
+const (
+	AttrDomain          = attribute.Key("domain")
+	AttrPriceDirection  = attribute.Key("price.direction")
+	AttrUserID          = attribute.Key("user.id")
+	AttrProductID       = attribute.Key("product.id")
+	AttrProductName     = attribute.Key("product.name")
+	AttrProductCategory = attribute.Key("product.category")
+	AttrProductPrice    = attribute.Key("product.price")
+	AttrProductTags     = attribute.Key("product.tags")
+	AttrMinPrice        = attribute.Key("filter.min_price")
+	AttrMaxPrice        = attribute.Key("filter.max_price")
+	AttrAllTags         = attribute.Key("filter.all_tags")
+	AttrHasThumbnail    = attribute.Key("filter.has_thumbnail")
+	AttrSearchQuery     = attribute.Key("search.query")
+	AttrUniqueField     = attribute.Key("user.unique_field")
+	AttrBatchSize       = attribute.Key("batch.size")
+	AttrBatchAtomic     = attribute.Key("batch.atomic")
+	AttrImportJobID     = attribute.Key("import.job_id")
+	AttrImportDryRun    = attribute.Key("import.dry_run")
+	AttrPage            = attribute.Key("pagination.page")
+	AttrPageSize        = attribute.Key("pagination.size")
+)
+
+// SpanAttributes returns the username, email and phone of the user, masked
+// as personal data, and its id once it has one.
+func (u *User) SpanAttributes() []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		redact.Attr("user.username", u.Username),
+		redact.Attr("user.email", u.Email),
+		redact.Attr("user.phone", u.Phone),
+	}
+	if u.ID != "" {
+		attrs = append(attrs, AttrUserID.String(u.ID))
+	}
+	return attrs
+}
+
+// SpanAttributes returns the name, category and price of the product, and
+// its id once it has one.
+func (p *Product) SpanAttributes() []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		AttrProductName.String(p.Name),
+		AttrProductCategory.String(p.Category),
+		AttrProductPrice.Float64(float64(p.Price)),
+	}
+	if p.ID != "" {
+		attrs = append(attrs, AttrProductID.String(p.ID))
+	}
+	return attrs
+}
+
+// SpanAttributes returns the page number and size.
+func (p *Pagination) SpanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttrPage.Int(int(p.Page)),
+		AttrPageSize.Int(int(p.Size)),
+	}
+}
+
+// SpanAttributes returns the criteria set in the filter, leaving out the
+// unset ones.
+func (f *ProductFilter) SpanAttributes() []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if f.Category != nil {
+		attrs = append(attrs, AttrProductCategory.String(*f.Category))
+	}
+	if len(f.Tags) > 0 {
+		attrs = append(attrs, AttrProductTags.StringSlice(f.Tags), AttrAllTags.Bool(f.AllTags))
+	}
+	if f.MinPrice != nil {
+		attrs = append(attrs, AttrMinPrice.Float64(float64(*f.MinPrice)))
+	}
+	if f.MaxPrice != nil {
+		attrs = append(attrs, AttrMaxPrice.Float64(float64(*f.MaxPrice)))
+	}
+	if f.HasThumbnail != nil {
+		attrs = append(attrs, AttrHasThumbnail.Bool(*f.HasThumbnail))
+	}
+	return attrs
+}
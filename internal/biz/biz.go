@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var BizProviderSet = wire.NewSet(NewUsersUsecase, NewProductsUsecase)
+var BizProviderSet = wire.NewSet(NewMetrics, NewUsersUsecase, NewProductsUsecase)
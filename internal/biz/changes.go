@@ -0,0 +1,23 @@
+package biz
+
+import "time"
+
+type ChangeType string
+
+const (
+	ChangeCreated ChangeType = "created"
+	ChangeUpdated ChangeType = "updated"
+	ChangeDeleted ChangeType = "deleted"
+)
+
+// Change is a change of a watched entity. Value is the entity after the
+// change, unset when it was deleted.
+type Change[T any] struct {
+	Type  ChangeType `json:"type"`
+	ID    string     `json:"id"`
+	Value T          `json:"value,omitempty"`
+	Time  time.Time  `json:"time"`
+	// ResumeToken is set by the store replaying the change: watching again
+	// from it starts right after this change.
+	ResumeToken string `json:"-"`
+}
@@ -1,5 +1,11 @@
 package biz
 
+import (
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
 type Pagination struct {
 	Page int32 `json:"page"`
 	Size int32 `json:"size"`
@@ -52,3 +58,23 @@ type Audit struct {
 	UpdatedAt string `json:"updated_at"`
 	DeletedAt string `json:"deleted_at"`
 }
+
+// BatchItem is the outcome of one item of a batch request. Batches return
+// one item per requested entity, in request order.
+type BatchItem[T any] struct {
+	Value T
+	Err   error
+}
+
+// FirstError returns the error of the first failed item, naming its index,
+// or nil when every item succeeded.
+func FirstError[T any](items []BatchItem[T]) error {
+	for i, item := range items {
+		if item.Err == nil {
+			continue
+		}
+		se := errors.FromError(item.Err)
+		return errors.New(int(se.Code), se.Reason, fmt.Sprintf("item %d: %s", i, se.Message)).WithCause(item.Err)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"layout/pkg/monitor"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+type ImportStatus string
+
+const (
+	ImportPending   ImportStatus = "pending"
+	ImportRunning   ImportStatus = "running"
+	ImportSucceeded ImportStatus = "succeeded"
+	ImportFailed    ImportStatus = "failed"
+)
+
+const (
+	// importChunkSize is the number of valid rows inserted at once.
+	importChunkSize = 500
+	// maxImportErrors caps the error report of a job; rows past it are
+	// still counted as failed.
+	maxImportErrors = 1000
+)
+
+// ImportError is a rejected row of an import, Field being empty when the
+// whole row was rejected.
+type ImportError struct {
+	Line    int    `json:"line"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportRow is a decoded row of an upload: either its product or the reasons
+// it was rejected.
+type ImportRow struct {
+	Line    int
+	Product *Product
+	Errors  []ImportError
+}
+
+// ImportSource yields the rows of an upload in file order.
+type ImportSource interface {
+	// Next returns the next row, and false once the upload is exhausted or
+	// could not be read further, Err telling which.
+	Next() (ImportRow, bool)
+	Err() error
+	Close() error
+}
+
+type ImportJob struct {
+	ID         string        `json:"id"`
+	Status     ImportStatus  `json:"status"`
+	DryRun     bool          `json:"dry_run"`
+	Rows       int           `json:"rows"`
+	Imported   int           `json:"imported"`
+	Failed     int           `json:"failed"`
+	Errors     []ImportError `json:"errors"`
+	Error      string        `json:"error,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	FinishedAt time.Time     `json:"finished_at"`
+}
+
+func (j *ImportJob) reject(errs ...ImportError) {
+	j.Failed++
+	room := maxImportErrors - len(j.Errors)
+	if room <= 0 {
+		return
+	}
+	if len(errs) > room {
+		errs = errs[:room]
+	}
+	j.Errors = append(j.Errors, errs...)
+}
+
+// ImportJobsRepo keeps import jobs for their status to be polled.
+type ImportJobsRepo interface {
+	Save(ctx context.Context, job *ImportJob) error
+	Get(ctx context.Context, id string) (*ImportJob, error)
+}
+
+// StartImport registers an import job reading src and runs it in the
+// background; src is closed once the job ends. In a dry run every row is
+// validated but none is inserted.
+func (uc *ProductsUsecase) StartImport(ctx context.Context, src ImportSource, dryRun bool) (_ *ImportJob, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.StartImport", AttrImportDryRun.Bool(dryRun))
+	defer func() { monitor.EndSpan(span, err) }()
+	job := &ImportJob{
+		ID:        uuid.NewString(),
+		Status:    ImportPending,
+		DryRun:    dryRun,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := uc.jobs.Save(ctx, job); err != nil {
+		src.Close()
+		return nil, err
+	}
+	span.SetAttributes(AttrImportJobID.String(job.ID))
+	snapshot := *job
+	go uc.runImport(context.WithoutCancel(ctx), job, src)
+	return &snapshot, nil
+}
+
+func (uc *ProductsUsecase) GetImportJob(ctx context.Context, id string) (_ *ImportJob, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.GetImportJob", AttrImportJobID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
+	return uc.jobs.Get(ctx, id)
+}
+
+// runImport inserts the valid rows of src in chunks, saving the progress of
+// job after each of them.
+func (uc *ProductsUsecase) runImport(ctx context.Context, job *ImportJob, src ImportSource) {
+	var err error
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.runImport", AttrImportJobID.String(job.ID), AttrImportDryRun.Bool(job.DryRun))
+	defer func() { monitor.EndSpan(span, err) }()
+	defer src.Close()
+
+	job.Status = ImportRunning
+	uc.saveJob(ctx, job)
+	chunk := make([]ImportRow, 0, importChunkSize)
+	for err == nil {
+		row, ok := src.Next()
+		if !ok {
+			break
+		}
+		job.Rows++
+		if len(row.Errors) > 0 {
+			job.reject(row.Errors...)
+			continue
+		}
+		if chunk = append(chunk, row); len(chunk) == importChunkSize {
+			err = uc.importChunk(ctx, job, chunk)
+			chunk = chunk[:0]
+			uc.saveJob(ctx, job)
+		}
+	}
+	if err == nil {
+		err = src.Err()
+	}
+	if err == nil && len(chunk) > 0 {
+		err = uc.importChunk(ctx, job, chunk)
+	}
+
+	// rows refused by the store are reported after the invalid rows that
+	// followed them in their chunk
+	slices.SortStableFunc(job.Errors, func(a, b ImportError) int { return a.Line - b.Line })
+	job.Status = ImportSucceeded
+	if err != nil {
+		job.Status = ImportFailed
+		job.Error = errors.FromError(err).Message
+	}
+	job.FinishedAt = time.Now().UTC()
+	uc.saveJob(ctx, job)
+}
+
+// importChunk inserts the products of rows, rejecting the ones the store
+// refuses, e.g. for a duplicate SKU.
+func (uc *ProductsUsecase) importChunk(ctx context.Context, job *ImportJob, rows []ImportRow) error {
+	if job.DryRun {
+		job.Imported += len(rows)
+		return nil
+	}
+	products := make([]*Product, len(rows))
+	for i, row := range rows {
+		products[i] = row.Product
+	}
+	items, err := uc.repo.SaveMany(ctx, products, false)
+	if err != nil {
+		return err
+	}
+	for i, item := range items {
+		if item.Err != nil {
+			job.reject(ImportError{Line: rows[i].Line, Message: errors.FromError(item.Err).Message})
+			continue
+		}
+		job.Imported++
+		uc.metrics.ProductCreated(ctx, products[i].Category)
+	}
+	return nil
+}
+
+func (uc *ProductsUsecase) saveJob(ctx context.Context, job *ImportJob) {
+	if err := uc.jobs.Save(ctx, job); err != nil {
+		uc.log.Warnf("failed to save import job %s: %s", job.ID, err)
+	}
+}
@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	DomainUsers    = "users"
+	DomainProducts = "products"
+)
+
+// Metrics are the business instruments of the users and products domains,
+// registered on the injected meter. The catalogue lives in README.md.
+type Metrics struct {
+	usersCreated    metric.Int64Counter
+	usersDeleted    metric.Int64Counter
+	productsCreated metric.Int64Counter
+	priceChanges    metric.Int64Counter
+	searches        metric.Int64Counter
+	searchesEmpty   metric.Int64Counter
+}
+
+func NewMetrics(meter metric.Meter) (*Metrics, error) {
+	var (
+		m   Metrics
+		err error
+	)
+	counters := []struct {
+		dst         *metric.Int64Counter
+		name, unit  string
+		description string
+	}{
+		{&m.usersCreated, "users.created", "{user}", "Users created."},
+		{&m.usersDeleted, "users.deleted", "{user}", "Users deleted."},
+		{&m.productsCreated, "products.created", "{product}", "Products created by category."},
+		{&m.priceChanges, "products.price_changes", "{change}", "Product price changes by category and direction."},
+		{&m.searches, "search.queries", "{query}", "Search queries by domain."},
+		{&m.searchesEmpty, "search.zero_results", "{query}", "Search queries returning no result by domain."},
+	}
+	for _, c := range counters {
+		*c.dst, err = meter.Int64Counter(c.name, metric.WithUnit(c.unit), metric.WithDescription(c.description))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &m, nil
+}
+
+func (m *Metrics) UserCreated(ctx context.Context) {
+	m.usersCreated.Add(ctx, 1)
+}
+
+func (m *Metrics) UserDeleted(ctx context.Context) {
+	m.usersDeleted.Add(ctx, 1)
+}
+
+func (m *Metrics) ProductCreated(ctx context.Context, category string) {
+	m.productsCreated.Add(ctx, 1, metric.WithAttributes(AttrProductCategory.String(category)))
+}
+
+// PriceChanged records a product price going from old to new; equal prices
+// are ignored.
+func (m *Metrics) PriceChanged(ctx context.Context, category string, old, new float32) {
+	if old == new {
+		return
+	}
+	m.priceChanges.Add(ctx, 1, metric.WithAttributes(
+		AttrProductCategory.String(category),
+		AttrPriceDirection.String(result(new > old, "up", "down")),
+	))
+}
+
+// Searched records a search query of domain and whether it found nothing.
+func (m *Metrics) Searched(ctx context.Context, domain string, results int) {
+	attrs := metric.WithAttributes(AttrDomain.String(domain))
+	m.searches.Add(ctx, 1, attrs)
+	if results == 0 {
+		m.searchesEmpty.Add(ctx, 1, attrs)
+	}
+}
+
+func result(ok bool, yes, no string) string {
+	if ok {
+		return yes
+	}
+	return no
+}
@@ -2,10 +2,11 @@ package biz
 
 import (
 	"context"
-	"fmt"
+
+	"layout/pkg/monitor"
+
 	"github.com/go-kratos/kratos/v2/log"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Product struct {
@@ -18,50 +19,99 @@ type Product struct {
 	Attributes  map[string]string `json:"attributes"`
 	Thumbnail   *string           `json:"thumbnail"`
 	Images      []string          `json:"images"`
+	SKU         string            `json:"sku"`
+}
+
+// ProductFilter narrows the products listed or exported, unset fields
+// matching every product.
+type ProductFilter struct {
+	Category *string
+	// Tags match products having any of them, or all of them with AllTags.
+	Tags     []string
+	AllTags  bool
+	MinPrice *float32
+	MaxPrice *float32
+	// Attributes match products having every key with its value.
+	Attributes   map[string]string
+	HasThumbnail *bool
+}
+
+// ProductSortField is a field products are listed by.
+type ProductSortField string
+
+const (
+	SortByPrice     ProductSortField = "price"
+	SortByName      ProductSortField = "name"
+	SortByCreatedAt ProductSortField = "created_at"
+)
+
+// ProductOrder is a sort key of a listing. Products equal on every key are
+// listed oldest first, so pages never overlap.
+type ProductOrder struct {
+	Field ProductSortField
+	Desc  bool
 }
 
 type ProductsRepo interface {
 	Save(ctx context.Context, p *Product) (string, error)
+	// SaveMany inserts ps at once and returns their ids. In atomic mode
+	// either all of them are inserted or the error of the batch is returned.
+	SaveMany(ctx context.Context, ps []*Product, atomic bool) ([]BatchItem[string], error)
 	GetByID(ctx context.Context, id string) (*Product, error)
-	List(ctx context.Context, pagination *Pagination) ([]*Product, error)
-	Update(ctx context.Context, p *Product) (*Product, error)
+	// List returns a page of the products matching filter, sorted by order.
+	List(ctx context.Context, filter *ProductFilter, order []ProductOrder, pagination *Pagination) ([]*Product, error)
+	// Update replaces the product of p.ID with p and returns it along with
+	// the product it replaced.
+	Update(ctx context.Context, p *Product) (updated, prev *Product, err error)
 	Delete(ctx context.Context, id string) (string, error)
-	Search(ctx context.Context, keyword string, pagination *Pagination) ([]*Product, error)
+	// DeleteMany deletes the products of ids at once. In atomic mode either
+	// all of them are deleted or the error of the batch is returned.
+	DeleteMany(ctx context.Context, ids []string, atomic bool) ([]BatchItem[string], error)
+	// Search returns a page of the products matching search, scored by
+	// relevance, along with the facets of all of them.
+	Search(ctx context.Context, search *ProductSearch, pagination *Pagination) (*ProductSearchResult, error)
+	// Stream calls fn with every product matching filter, stopping at the
+	// first error fn returns.
+	Stream(ctx context.Context, filter *ProductFilter, fn func(*Product) error) error
+	// Watch calls fn with every change of products after resumeToken, or
+	// from now on without one, until ctx ends or fn fails.
+	Watch(ctx context.Context, resumeToken string, fn func(*Change[*Product]) error) error
 }
 
 type ProductsUsecase struct {
-	repo ProductsRepo
-	log  *log.Helper
+	repo        ProductsRepo
+	jobs        ImportJobsRepo
+	suggestions ProductSuggestionsRepo
+	metrics     *Metrics
+	log         *log.Helper
+	tracer      trace.Tracer
 }
 
-func NewProductsUsecase(repo ProductsRepo, logger log.Logger) *ProductsUsecase {
+func NewProductsUsecase(repo ProductsRepo, jobs ImportJobsRepo, suggestions ProductSuggestionsRepo, metrics *Metrics, logger log.Logger, tracer trace.Tracer) *ProductsUsecase {
 	return &ProductsUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:        repo,
+		jobs:        jobs,
+		suggestions: suggestions,
+		metrics:     metrics,
+		log:         log.NewHelper(logger),
+		tracer:      tracer,
 	}
 }
 
-func (uc *ProductsUsecase) CreateProduct(ctx context.Context, p *Product) (string, error) {
-	_, span := otel.Tracer("products").Start(ctx, "CreateProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "product",
-		Value: attribute.StringValue(fmt.Sprintf("Name: %s, Desc %s, Category: %s, Price: %f", p.Name, p.Description, p.Category, p.Price)),
-	})
+func (uc *ProductsUsecase) CreateProduct(ctx context.Context, p *Product) (_ string, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.CreateProduct", p.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 	res, err := uc.repo.Save(ctx, p)
 	if err != nil {
 		return "", err
 	}
+	uc.metrics.ProductCreated(ctx, p.Category)
 	return res, nil
 }
 
-func (uc *ProductsUsecase) GetProduct(ctx context.Context, id string) (*Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "GetProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (uc *ProductsUsecase) GetProduct(ctx context.Context, id string) (_ *Product, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.GetProduct", AttrProductID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 	res, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -69,41 +119,30 @@ func (uc *ProductsUsecase) GetProduct(ctx context.Context, id string) (*Product,
 	return res, nil
 }
 
-func (uc *ProductsUsecase) ListProducts(ctx context.Context, p *Pagination) ([]*Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "ListProducts")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", p.Page, p.Size)),
-	})
-	res, err := uc.repo.List(ctx, p)
+func (uc *ProductsUsecase) ListProducts(ctx context.Context, filter *ProductFilter, order []ProductOrder, p *Pagination) (_ []*Product, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.ListProducts", append(p.SpanAttributes(), filter.SpanAttributes()...)...)
+	defer func() { monitor.EndSpan(span, err) }()
+	res, err := uc.repo.List(ctx, filter, order, p)
 	if err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (uc *ProductsUsecase) UpdateProduct(ctx context.Context, p *Product) (*Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "UpdateProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "product",
-		Value: attribute.StringValue(fmt.Sprintf("Name: %s, Desc %s, Category: %s, Price: %f", p.Name, p.Description, p.Category, p.Price)),
-	})
-	res, err := uc.repo.Update(ctx, p)
+func (uc *ProductsUsecase) UpdateProduct(ctx context.Context, p *Product) (_ *Product, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.UpdateProduct", p.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
+	res, prev, err := uc.repo.Update(ctx, p)
 	if err != nil {
 		return nil, err
 	}
+	uc.metrics.PriceChanged(ctx, res.Category, prev.Price, res.Price)
 	return res, nil
 }
 
-func (uc *ProductsUsecase) DeleteProduct(ctx context.Context, id string) (string, error) {
-	_, span := otel.Tracer("products").Start(ctx, "DeleteProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (uc *ProductsUsecase) DeleteProduct(ctx context.Context, id string) (_ string, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.DeleteProduct", AttrProductID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 	res, err := uc.repo.Delete(ctx, id)
 	if err != nil {
 		return "", err
@@ -111,20 +150,74 @@ func (uc *ProductsUsecase) DeleteProduct(ctx context.Context, id string) (string
 	return res, nil
 }
 
-func (uc *ProductsUsecase) SearchProducts(ctx context.Context, keyword string, p *Pagination) ([]*Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "SearchProducts")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "keyword",
-		Value: attribute.StringValue(keyword),
-	})
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", p.Page, p.Size)),
-	})
-	res, err := uc.repo.Search(ctx, keyword, p)
+// SearchProducts runs a faceted search and highlights the terms of the
+// query in the hits.
+func (uc *ProductsUsecase) SearchProducts(ctx context.Context, search *ProductSearch, p *Pagination) (_ *ProductSearchResult, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.SearchProducts", append(append(p.SpanAttributes(), AttrSearchQuery.String(search.Query)), search.Filter.SpanAttributes()...)...)
+	defer func() { monitor.EndSpan(span, err) }()
+	if len(search.PriceBuckets) == 0 {
+		search.PriceBuckets = DefaultPriceBuckets
+	}
+	res, err := uc.repo.Search(ctx, search, p)
 	if err != nil {
 		return nil, err
 	}
+	if terms := searchTerms(search.Query); len(terms) > 0 {
+		for _, hit := range res.Hits {
+			highlightHit(hit, terms)
+		}
+	}
+	uc.metrics.Searched(ctx, DomainProducts, int(res.Total))
+	if res.Total > 0 {
+		if err := uc.suggestions.RecordSearch(ctx, search.Query); err != nil {
+			uc.log.Warnf("failed to record the search of %q: %s", search.Query, err)
+		}
+	}
 	return res, nil
 }
+
+// SuggestProducts completes prefix with product names and tags.
+func (uc *ProductsUsecase) SuggestProducts(ctx context.Context, prefix string, limit int) (_ []Suggestion, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.SuggestProducts", AttrSearchQuery.String(prefix))
+	defer func() { monitor.EndSpan(span, err) }()
+	if limit <= 0 {
+		limit = defaultSuggestions
+	}
+	return uc.suggestions.Suggest(ctx, prefix, limit)
+}
+
+func (uc *ProductsUsecase) BatchCreateProducts(ctx context.Context, ps []*Product, atomic bool) (_ []BatchItem[string], err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.BatchCreateProducts", AttrBatchSize.Int(len(ps)), AttrBatchAtomic.Bool(atomic))
+	defer func() { monitor.EndSpan(span, err) }()
+	items, err := uc.repo.SaveMany(ctx, ps, atomic)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items {
+		if item.Err == nil {
+			uc.metrics.ProductCreated(ctx, ps[i].Category)
+		}
+	}
+	return items, nil
+}
+
+func (uc *ProductsUsecase) BatchDeleteProducts(ctx context.Context, ids []string, atomic bool) (_ []BatchItem[string], err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.BatchDeleteProducts", AttrBatchSize.Int(len(ids)), AttrBatchAtomic.Bool(atomic))
+	defer func() { monitor.EndSpan(span, err) }()
+	return uc.repo.DeleteMany(ctx, ids, atomic)
+}
+
+// ExportProducts calls fn with every product matching filter.
+func (uc *ProductsUsecase) ExportProducts(ctx context.Context, filter *ProductFilter, fn func(*Product) error) (err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.ExportProducts", filter.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
+	return uc.repo.Stream(ctx, filter, fn)
+}
+
+// WatchProducts calls fn with every change of products after resumeToken, or
+// from now on without one, until ctx ends or fn fails.
+func (uc *ProductsUsecase) WatchProducts(ctx context.Context, resumeToken string, fn func(*Change[*Product]) error) (err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "ProductsUsecase.WatchProducts")
+	defer func() { monitor.EndSpan(span, err) }()
+	return uc.repo.Watch(ctx, resumeToken, fn)
+}
@@ -0,0 +1,220 @@
+package biz
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// SortByRelevance sorts searched products by decreasing text score.
+const SortByRelevance ProductSortField = "relevance"
+
+// DefaultPriceBuckets are the lower bounds of the price facet buckets when a
+// search names none.
+var DefaultPriceBuckets = []float32{0, 10, 25, 50, 100, 250, 500, 1000}
+
+// ProductSearch is a faceted full-text search of products. An empty query
+// matches every product of the filter.
+type ProductSearch struct {
+	Query  string
+	Filter *ProductFilter
+	// Order defaults to relevance.
+	Order []ProductOrder
+	// PriceBuckets are the increasing lower bounds of the price facet
+	// buckets, the last one being open ended.
+	PriceBuckets []float32
+}
+
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// PriceBucket counts the products priced from Min to Max excluded, Max
+// being unset for the last bucket.
+type PriceBucket struct {
+	Min   float32
+	Max   *float32
+	Count int64
+}
+
+type AttributeFacet struct {
+	Key    string
+	Values []FacetCount
+}
+
+// ProductFacets count the products matching a search, the most frequent
+// values first.
+type ProductFacets struct {
+	Categories []FacetCount
+	Tags       []FacetCount
+	Prices     []PriceBucket
+	Attributes []AttributeFacet
+}
+
+// Highlight is a fragment of Field with the matched terms wrapped in <em>.
+type Highlight struct {
+	Field   string
+	Snippet string
+}
+
+type SearchHit struct {
+	Product    *Product
+	Score      float64
+	Highlights []Highlight
+}
+
+// ProductSearchResult is a page of hits, Total and Facets covering every
+// product matching the search.
+type ProductSearchResult struct {
+	Hits   []*SearchHit
+	Total  int64
+	Facets ProductFacets
+}
+
+// snippetWords is the length of description highlights, which start
+// snippetContext words before the first match.
+const (
+	snippetWords   = 30
+	snippetContext = 8
+)
+
+// searchTerms returns the stems of the words a text query looks for,
+// leaving out the excluded ones.
+func searchTerms(query string) []string {
+	var terms []string
+	for i, part := range strings.Split(query, `"`) {
+		// odd parts are quoted phrases, whose words all count
+		for _, w := range strings.Fields(part) {
+			if i%2 == 0 && strings.HasPrefix(w, "-") {
+				continue
+			}
+			for _, word := range strings.FieldsFunc(strings.ToLower(w), notWordRune) {
+				terms = append(terms, stem(word))
+			}
+		}
+	}
+	return terms
+}
+
+// stem strips the common English suffixes, close enough to the stemming of
+// the text index for highlights.
+func stem(word string) string {
+	for _, suffix := range []string{"ing", "ed"} {
+		if s, ok := strings.CutSuffix(word, suffix); ok && len(s) >= 3 {
+			return s
+		}
+	}
+	// boxes and dishes lose es, shoes only s
+	if s, ok := strings.CutSuffix(word, "es"); ok && len(s) >= 3 && sibilant(s) {
+		return s
+	}
+	if s, ok := strings.CutSuffix(word, "s"); ok && len(s) >= 3 && !strings.HasSuffix(s, "s") {
+		return s
+	}
+	return word
+}
+
+func sibilant(word string) bool {
+	for _, end := range []string{"s", "x", "z", "ch", "sh"} {
+		if strings.HasSuffix(word, end) {
+			return true
+		}
+	}
+	return false
+}
+
+func notWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// wordSpan is the byte range of a word of a text.
+type wordSpan struct {
+	start, end int
+	match      bool
+}
+
+// wordSpans splits text into words, marking the ones starting with a term.
+func wordSpans(text string, terms []string) []wordSpan {
+	var spans []wordSpan
+	start := -1
+	for i, r := range text + " " {
+		switch {
+		case !notWordRune(r) && start < 0:
+			start = i
+		case notWordRune(r) && start >= 0:
+			word := strings.ToLower(text[start:i])
+			matched := false
+			for _, t := range terms {
+				if strings.HasPrefix(word, t) {
+					matched = true
+					break
+				}
+			}
+			spans = append(spans, wordSpan{start: start, end: i, match: matched})
+			start = -1
+		}
+	}
+	return spans
+}
+
+// highlight returns the fragment of text around the matches of terms, or
+// false when none matched. Fragments of at most words words start lead
+// words before the first match; words <= 0 keeps the whole text.
+func highlight(text string, terms []string, words, lead int) (string, bool) {
+	spans := wordSpans(text, terms)
+	first := -1
+	for i, s := range spans {
+		if s.match {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return "", false
+	}
+	from, to := 0, len(spans)
+	if words > 0 {
+		from = max(0, first-lead)
+		to = min(len(spans), from+words)
+	}
+	start, end := 0, len(text)
+	if from > 0 {
+		start = spans[from].start
+	}
+	if to < len(spans) {
+		end = spans[to-1].end
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, s := range spans[from:to] {
+		if !s.match {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:s.start]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(text[s.start:s.end]))
+		b.WriteString("</em>")
+		pos = s.end
+	}
+	b.WriteString(html.EscapeString(text[pos:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
+
+// highlightHit adds the highlights of the name and the description of the
+// hit matching terms.
+func highlightHit(hit *SearchHit, terms []string) {
+	if s, ok := highlight(hit.Product.Name, terms, 0, 0); ok {
+		hit.Highlights = append(hit.Highlights, Highlight{Field: "name", Snippet: s})
+	}
+	if s, ok := highlight(hit.Product.Description, terms, snippetWords, snippetContext); ok {
+		hit.Highlights = append(hit.Highlights, Highlight{Field: "description", Snippet: s})
+	}
+}
@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"boxes", "box"},
+		{"dishes", "dish"},
+		{"watches", "watch"},
+		{"shoes", "shoe"},
+		{"lamps", "lamp"},
+		{"glass", "glass"},
+		{"running", "runn"},
+		{"painted", "paint"},
+		{"red", "red"},
+		{"bus", "bus"},
+		{"is", "is"},
+		{"sing", "sing"},
+	}
+	for _, tt := range tests {
+		if got := stem(tt.word); got != tt.want {
+			t.Errorf("stem(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", nil},
+		{"Red Shoes", []string{"red", "shoe"}},
+		{"lamp -desk", []string{"lamp"}},
+		{`"wooden -desk" lamps`, []string{"wooden", "desk", "lamp"}},
+		{"hi-fi", []string{"hi", "fi"}},
+	}
+	for _, tt := range tests {
+		if got := searchTerms(tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("searchTerms(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		terms       []string
+		words, lead int
+		want        string
+		ok          bool
+	}{
+		{
+			name:  "no match",
+			text:  "A wooden desk",
+			terms: []string{"lamp"},
+		},
+		{
+			name:  "whole text",
+			text:  "Red shoes, blue shoes",
+			terms: []string{"shoe"},
+			want:  "Red <em>shoes</em>, blue <em>shoes</em>",
+			ok:    true,
+		},
+		{
+			name:  "prefix of a word",
+			text:  "Running shoes",
+			terms: []string{"runn"},
+			want:  "<em>Running</em> shoes",
+			ok:    true,
+		},
+		{
+			name:  "escaped",
+			text:  "Desk <b>lamp</b> & shade",
+			terms: []string{"lamp"},
+			want:  "Desk &lt;b&gt;<em>lamp</em>&lt;/b&gt; &amp; shade",
+			ok:    true,
+		},
+		{
+			name:  "fragment",
+			text:  "one two three four five lamp six seven eight",
+			terms: []string{"lamp"},
+			words: 3,
+			lead:  1,
+			want:  "…five <em>lamp</em> six…",
+			ok:    true,
+		},
+		{
+			name:  "fragment at the start",
+			text:  "lamp one two three",
+			terms: []string{"lamp"},
+			words: 2,
+			lead:  5,
+			want:  "<em>lamp</em> one…",
+			ok:    true,
+		},
+		{
+			name:  "fragment at the end",
+			text:  "one two three lamp",
+			terms: []string{"lamp"},
+			words: 5,
+			lead:  1,
+			want:  "…three <em>lamp</em>",
+			ok:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := highlight(tt.text, tt.terms, tt.words, tt.lead)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("highlight() = %q, %v, want %q, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package biz
+
+import "context"
+
+// defaultSuggestions is the number of suggestions of a prefix when the
+// request names none.
+const defaultSuggestions = 10
+
+type SuggestionKind string
+
+const (
+	SuggestionName SuggestionKind = "name"
+	SuggestionTag  SuggestionKind = "tag"
+)
+
+// Suggestion completes a search box prefix with a product name or tag.
+type Suggestion struct {
+	Text string
+	Kind SuggestionKind
+}
+
+// ProductSuggestionsRepo reads the completions index of product names and
+// tags, which the products store keeps up to date.
+type ProductSuggestionsRepo interface {
+	// Suggest returns at most limit completions of prefix, the most searched
+	// first.
+	Suggest(ctx context.Context, prefix string, limit int) ([]Suggestion, error)
+	// RecordSearch counts a search of query towards the popularity of the
+	// completion equal to it, if any.
+	RecordSearch(ctx context.Context, query string) error
+}
@@ -2,11 +2,14 @@ package biz
 
 import (
 	"context"
-	"fmt"
-	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+
+	v1 "layout/api/users/v1"
+	"layout/pkg/monitor"
+	"layout/pkg/redact"
+
 	"github.com/go-kratos/kratos/v2/log"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type User struct {
@@ -24,48 +27,75 @@ type User struct {
 type UsersRepo interface {
 	Save(ctx context.Context, u *User) (string, error)
 	GetByID(ctx context.Context, id string) (*User, error)
+	// GetByIDs fetches the users of ids in one query.
+	GetByIDs(ctx context.Context, ids []string) ([]BatchItem[*User], error)
 	List(ctx context.Context, pagination *Pagination) ([]*User, error)
 	Update(ctx context.Context, u *User) (*User, error)
 	Delete(ctx context.Context, id string) (*User, error)
-	Search(ctx context.Context, keyword string, pagination *Pagination) ([]*User, error)
+	// Search returns a page of the users matching keyword, the most relevant
+	// first, and an empty page when none does, along with the number of
+	// users matching.
+	Search(ctx context.Context, keyword string, pagination *Pagination) ([]*User, int64, error)
+	// Exists reports whether a user, deleted ones included, holds value in
+	// the unique field.
+	Exists(ctx context.Context, field UniqueField, value string) (bool, error)
+}
+
+// UserEventsRepo publishes the changes of users and replays them to watchers.
+type UserEventsRepo interface {
+	Publish(ctx context.Context, change *Change[*User]) error
+	// Watch calls fn with every change published after resumeToken, or from
+	// now on without one, until ctx ends or fn fails.
+	Watch(ctx context.Context, resumeToken string, fn func(*Change[*User]) error) error
 }
 
+// UniqueField is a user field no two users may share.
+type UniqueField string
+
+const (
+	FieldUsername UniqueField = "username"
+	FieldEmail    UniqueField = "email"
+	FieldPhone    UniqueField = "phone"
+)
+
 type UsersUsecase struct {
-	repo UsersRepo
-	log  *log.Helper
+	repo    UsersRepo
+	events  UserEventsRepo
+	metrics *Metrics
+	log     *log.Helper
+	tracer  trace.Tracer
 }
 
-func NewUsersUsecase(repo UsersRepo, logger log.Logger) *UsersUsecase {
+func NewUsersUsecase(repo UsersRepo, events UserEventsRepo, metrics *Metrics, logger log.Logger, tracer trace.Tracer) *UsersUsecase {
 	return &UsersUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:    repo,
+		events:  events,
+		metrics: metrics,
+		log:     log.NewHelper(logger),
+		tracer:  tracer,
 	}
 }
 
-func (uc *UsersUsecase) CreateUser(ctx context.Context, u *User) (string, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersUsecase.CreateUser")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "user",
-		Value: attribute.StringValue(u.Username + " " + u.Email + " " + u.Phone),
-	})
+func (uc *UsersUsecase) CreateUser(ctx context.Context, u *User) (_ string, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.CreateUser", u.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 
 	res, err := uc.repo.Save(ctx, u)
 	if err != nil {
 		return "", err
 	}
 	if res == "" {
-		return "", errors.InternalServer("failed to save user", "err was empty but insertions failed")
+		return "", v1.ErrorUserInternal("user was not saved")
 	}
+	uc.metrics.UserCreated(ctx)
+	created := *u
+	created.ID = res
+	uc.publish(ctx, ChangeCreated, res, &created)
 	return res, nil
 }
-func (uc *UsersUsecase) GetUser(ctx context.Context, id string) (*User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersUsecase.GetUser")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (uc *UsersUsecase) GetUser(ctx context.Context, id string) (_ *User, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.GetUser", AttrUserID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 	res, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -73,13 +103,9 @@ func (uc *UsersUsecase) GetUser(ctx context.Context, id string) (*User, error) {
 	return res, nil
 }
 
-func (uc *UsersUsecase) ListUsers(ctx context.Context, p *Pagination) ([]*User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersUsecase.ListUsers")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", p.Page, p.Size)),
-	})
+func (uc *UsersUsecase) ListUsers(ctx context.Context, p *Pagination) (_ []*User, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.ListUsers", p.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 
 	res, err := uc.repo.List(ctx, p)
 	if err != nil {
@@ -88,51 +114,90 @@ func (uc *UsersUsecase) ListUsers(ctx context.Context, p *Pagination) ([]*User,
 	return res, nil
 }
 
-func (uc *UsersUsecase) UpdateUser(ctx context.Context, u *User) (*User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersUsecase.UpdateUser")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "user",
-		Value: attribute.StringValue(u.Username + " " + u.Email + " " + u.Phone),
-	})
+func (uc *UsersUsecase) UpdateUser(ctx context.Context, u *User) (_ *User, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.UpdateUser", u.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 
 	res, err := uc.repo.Update(ctx, u)
 	if err != nil {
 		return nil, err
 	}
+	updated := *res
+	uc.publish(ctx, ChangeUpdated, res.ID, &updated)
 	return res, nil
 }
 
-func (uc *UsersUsecase) DeleteUser(ctx context.Context, id string) (*User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersUsecase.DeleteUser")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (uc *UsersUsecase) DeleteUser(ctx context.Context, id string) (_ *User, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.DeleteUser", AttrUserID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 
 	res, err := uc.repo.Delete(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	uc.metrics.UserDeleted(ctx)
+	uc.publish(ctx, ChangeDeleted, id, nil)
 	return res, nil
 }
 
-func (uc *UsersUsecase) SearchUsers(ctx context.Context, keyword string, p *Pagination) ([]*User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersUsecase.SearchUsers")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "keyword",
-		Value: attribute.StringValue(keyword),
-	})
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", p.Page, p.Size)),
-	})
-
-	res, err := uc.repo.Search(ctx, keyword, p)
+// SearchUsers returns a page of the users matching keyword and how many
+// match in all.
+func (uc *UsersUsecase) SearchUsers(ctx context.Context, keyword string, p *Pagination) (_ []*User, _ int64, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.SearchUsers", append(p.SpanAttributes(), redact.Attr(string(AttrSearchQuery), keyword))...)
+	defer func() { monitor.EndSpan(span, err) }()
+
+	res, total, err := uc.repo.Search(ctx, keyword, p)
+	if err != nil {
+		return nil, 0, err
+	}
+	uc.metrics.Searched(ctx, DomainUsers, int(total))
+	return res, total, nil
+}
+
+// CheckAvailability reports whether value is still free for field.
+func (uc *UsersUsecase) CheckAvailability(ctx context.Context, field UniqueField, value string) (_ bool, err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.CheckAvailability", AttrUniqueField.String(string(field)))
+	defer func() { monitor.EndSpan(span, err) }()
+	taken, err := uc.repo.Exists(ctx, field, value)
+	if err != nil {
+		return false, err
+	}
+	return !taken, nil
+}
+
+// BatchGetUsers fetches the users of ids. In atomic mode the first invalid or
+// unknown id fails the whole batch.
+func (uc *UsersUsecase) BatchGetUsers(ctx context.Context, ids []string, atomic bool) (_ []BatchItem[*User], err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.BatchGetUsers", AttrBatchSize.Int(len(ids)), AttrBatchAtomic.Bool(atomic))
+	defer func() { monitor.EndSpan(span, err) }()
+	items, err := uc.repo.GetByIDs(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
-	return res, nil
+	if atomic {
+		if err := FirstError(items); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// WatchUsers calls fn with every change of users after resumeToken, or from
+// now on without one, until ctx ends or fn fails.
+func (uc *UsersUsecase) WatchUsers(ctx context.Context, resumeToken string, fn func(*Change[*User]) error) (err error) {
+	ctx, span := monitor.StartSpan(ctx, uc.tracer, "UsersUsecase.WatchUsers")
+	defer func() { monitor.EndSpan(span, err) }()
+	return uc.events.Watch(ctx, resumeToken, fn)
+}
+
+// publish announces a change of the user u to watchers. The change is already
+// stored, so failing to publish it is only logged.
+func (uc *UsersUsecase) publish(ctx context.Context, typ ChangeType, id string, u *User) {
+	if u != nil {
+		u.Password = ""
+	}
+	change := &Change[*User]{Type: typ, ID: id, Value: u, Time: time.Now().UTC()}
+	if err := uc.events.Publish(ctx, change); err != nil {
+		uc.log.Warnf("failed to publish the %s change of user %s: %s", typ, id, err)
+	}
 }
@@ -15,7 +15,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var DataProviderSet = wire.NewSet(NewData, NewUsersRepo, NewProductsRepo)
+var DataProviderSet = wire.NewSet(NewData, NewUsersRepo, NewUserEventsRepo, NewProductsRepo, NewImportJobsRepo, NewProductSuggestionsRepo)
 
 // dataStruct .
 type dataStruct struct {
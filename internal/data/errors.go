@@ -0,0 +1,72 @@
+package data
+
+import (
+	"strings"
+
+	productsV1 "layout/api/products/v1"
+	usersV1 "layout/api/users/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/jackc/pgconn"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+)
+
+// pgUniqueViolation is the SQLSTATE of a unique constraint violation.
+const pgUniqueViolation = "23505"
+
+// MongoDB error codes of change streams: they need a replica set, and can
+// only resume while the oplog holds the resume token.
+const (
+	mongoErrNotReplicaSet = 40573
+	mongoErrHistoryLost   = 286
+)
+
+// userError maps a users store failure to the users error reasons, so
+// callers never see raw GORM or driver errors. The original error is kept
+// as the cause for logs.
+func userError(err error, id string) error {
+	if err == nil || errors.FromError(err).Reason != "" {
+		return err
+	}
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return usersV1.ErrorUserNotFound("user %s not found", id)
+	}
+	if field, ok := uniqueViolation(err); ok {
+		return usersV1.ErrorUserAlreadyExists("%s is already taken", field).
+			WithMetadata(map[string]string{"field": field}).
+			WithCause(err)
+	}
+	return usersV1.ErrorUserInternal("users store failure").WithCause(err)
+}
+
+// productError maps a products store failure to the products error reasons.
+func productError(err error, id string) error {
+	if err == nil || errors.FromError(err).Reason != "" {
+		return err
+	}
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return productsV1.ErrorProductNotFound("product %s not found", id)
+	case mongo.IsDuplicateKeyError(err):
+		return productsV1.ErrorProductAlreadyExists("product already exists").WithCause(err)
+	}
+	return productsV1.ErrorProductInternal("products store failure").WithCause(err)
+}
+
+// uniqueViolation reports whether err is a unique violation and names the
+// column of the violated index. Indexes follow the idx_<table>_<column>
+// naming of the migrations; the offending value, in the error detail, is
+// never exposed.
+func uniqueViolation(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgUniqueViolation {
+		return "", false
+	}
+	field := strings.TrimPrefix(pgErr.ConstraintName, "idx_"+pgErr.TableName+"_")
+	if field == "" || field == pgErr.ConstraintName {
+		field = "value"
+	}
+	return field, true
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"fmt"
+	"testing"
+
+	productsV1 "layout/api/products/v1"
+	usersV1 "layout/api/users/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/jackc/pgconn"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+)
+
+func TestUniqueViolation(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantField string
+		wantOK    bool
+	}{
+		{"other error", fmt.Errorf("connection refused"), "", false},
+		{"other SQLSTATE", &pgconn.PgError{Code: "23503", TableName: "users", ConstraintName: "fk_users_team"}, "", false},
+		{"named index", &pgconn.PgError{Code: "23505", TableName: "users", ConstraintName: "idx_users_email"}, "email", true},
+		{"wrapped", fmt.Errorf("save: %w", &pgconn.PgError{Code: "23505", TableName: "users", ConstraintName: "idx_users_phone"}), "phone", true},
+		{"unconventional index", &pgconn.PgError{Code: "23505", TableName: "users", ConstraintName: "users_pkey"}, "value", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, ok := uniqueViolation(tt.err)
+			if field != tt.wantField || ok != tt.wantOK {
+				t.Errorf("uniqueViolation() = %q, %v, want %q, %v", field, ok, tt.wantField, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestUserError(t *testing.T) {
+	notFound := usersV1.ErrorUserNotFound("user 42 not found")
+	tests := []struct {
+		name       string
+		err        error
+		wantReason string
+		wantField  string
+		wantCause  bool
+	}{
+		{"nil", nil, "", "", false},
+		{"not found", gorm.ErrRecordNotFound, usersV1.ErrorReason_USER_NOT_FOUND.String(), "", false},
+		{"unique violation", &pgconn.PgError{Code: "23505", TableName: "users", ConstraintName: "idx_users_username"}, usersV1.ErrorReason_USER_ALREADY_EXISTS.String(), "username", true},
+		{"already mapped", notFound, usersV1.ErrorReason_USER_NOT_FOUND.String(), "", false},
+		{"other", fmt.Errorf("connection refused"), usersV1.ErrorReason_USER_INTERNAL.String(), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := userError(tt.err, "42")
+			if tt.err == nil {
+				if got != nil {
+					t.Fatalf("userError(nil) = %v, want nil", got)
+				}
+				return
+			}
+			e := errors.FromError(got)
+			if e.Reason != tt.wantReason || e.Metadata["field"] != tt.wantField {
+				t.Errorf("userError() = %s field %q, want %s field %q", e.Reason, e.Metadata["field"], tt.wantReason, tt.wantField)
+			}
+			if tt.wantCause && e.Unwrap() != tt.err {
+				t.Errorf("userError() lost its cause %v", tt.err)
+			}
+		})
+	}
+}
+
+func TestProductError(t *testing.T) {
+	duplicate := mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 11000, Message: "E11000 duplicate key error"}}}
+	tests := []struct {
+		name       string
+		err        error
+		wantReason string
+	}{
+		{"nil", nil, ""},
+		{"not found", mongo.ErrNoDocuments, productsV1.ErrorReason_PRODUCT_NOT_FOUND.String()},
+		{"duplicate key", duplicate, productsV1.ErrorReason_PRODUCT_ALREADY_EXISTS.String()},
+		{"already mapped", productsV1.ErrorProductInvalidArgument("invalid product id"), productsV1.ErrorReason_PRODUCT_INVALID_ARGUMENT.String()},
+		{"other", fmt.Errorf("server selection timeout"), productsV1.ErrorReason_PRODUCT_INTERNAL.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := productError(tt.err, "42")
+			if tt.err == nil {
+				if got != nil {
+					t.Fatalf("productError(nil) = %v, want nil", got)
+				}
+				return
+			}
+			if reason := errors.FromError(got).Reason; reason != tt.wantReason {
+				t.Errorf("productError() reason = %s, want %s", reason, tt.wantReason)
+			}
+		})
+	}
+}
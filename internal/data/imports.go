@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+
+	productsV1 "layout/api/products/v1"
+	"layout/internal/biz"
+)
+
+// importJobTTL is how long a job can be polled after its last update.
+const importJobTTL = 24 * time.Hour
+
+// importJobsRepo stores import jobs as JSON under imports:<id> in redis, so
+// that any instance can report them. Without redis jobs are kept in memory
+// and only the instance running them knows them.
+type importJobsRepo struct {
+	rdb *redis.Client
+	log *log.Helper
+
+	mu  sync.Mutex
+	mem map[string][]byte
+}
+
+func NewImportJobsRepo(data Data, logger log.Logger) biz.ImportJobsRepo {
+	lg := log.NewHelper(logger)
+	if data.GetRedis() == nil {
+		lg.Warn("No Redis client found, import jobs are kept in memory")
+	}
+	return &importJobsRepo{rdb: data.GetRedis(), log: lg, mem: map[string][]byte{}}
+}
+
+func (r *importJobsRepo) key(id string) string {
+	return "imports:" + id
+}
+
+func (r *importJobsRepo) Save(ctx context.Context, job *biz.ImportJob) error {
+	raw, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	if r.rdb == nil {
+		r.mu.Lock()
+		r.mem[job.ID] = raw
+		r.mu.Unlock()
+		return nil
+	}
+	if err := r.rdb.Set(ctx, r.key(job.ID), raw, importJobTTL).Err(); err != nil {
+		r.log.Errorf("REDIS: failed to write %s: %s", r.key(job.ID), err)
+		return productsV1.ErrorProductInternal("import jobs store failure").WithCause(err)
+	}
+	return nil
+}
+
+func (r *importJobsRepo) Get(ctx context.Context, id string) (*biz.ImportJob, error) {
+	var (
+		raw []byte
+		err error
+	)
+	if r.rdb == nil {
+		r.mu.Lock()
+		raw = r.mem[id]
+		r.mu.Unlock()
+	} else if raw, err = r.rdb.Get(ctx, r.key(id)).Bytes(); err != nil && err != redis.Nil {
+		r.log.Errorf("REDIS: failed to read %s: %s", r.key(id), err)
+		return nil, productsV1.ErrorProductInternal("import jobs store failure").WithCause(err)
+	}
+	if raw == nil {
+		return nil, productsV1.ErrorProductNotFound("import job %s not found", id)
+	}
+	var job biz.ImportJob
+	if err := json.Unmarshal(raw, &job); err != nil {
+		return nil, productsV1.ErrorProductInternal("import jobs store failure").WithCause(err)
+	}
+	return &job, nil
+}
@@ -0,0 +1,19 @@
+package data
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Migrations returns the versioned SQL migrations for the postgres schema.
+// Files are named <version>_<name>.up.sql / <version>_<name>.down.sql.
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrationsFS, "migrations")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,20 @@
+-- Baseline schema for the users table. Uses IF NOT EXISTS so databases that
+-- were previously bootstrapped through gorm AutoMigrate adopt it unchanged.
+-- gen_random_uuid is built in from PostgreSQL 13, pgcrypto provides it before.
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+
+CREATE TABLE IF NOT EXISTS users (
+    id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    username   text NOT NULL,
+    email      text NOT NULL,
+    phone      text NOT NULL,
+    picture    text
+);
+
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username ON users (username);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_phone ON users (phone);
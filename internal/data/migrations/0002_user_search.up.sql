@@ -0,0 +1,16 @@
+-- Full-text and fuzzy search of users, see usersRepo.Search. The search
+-- column holds the words of the username, the email split on its
+-- punctuation and the digits of the phone, weighted in that order; the
+-- trigram indexes back similarity and substring matches.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple'::regconfig, username), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, regexp_replace(email, '[@._+-]+', ' ', 'g')), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, regexp_replace(phone, '\D', '', 'g')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_users_search ON users USING gin (search);
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING gin (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_email_trgm ON users USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_phone_trgm ON users USING gin (phone gin_trgm_ops);
@@ -2,7 +2,10 @@ package data
 
 import (
 	"context"
-	"fmt"
+	"encoding/base64"
+	"math"
+	"slices"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -11,15 +14,18 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 	"go.opentelemetry.io/otel/trace"
 
+	productsV1 "layout/api/products/v1"
 	"layout/internal/biz"
+	"layout/pkg/datasource"
+	"layout/pkg/monitor"
 )
 
 type Products struct {
-	ID          primitive.ObjectID `bson:"_id"`
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
 	Name        string             `bson:"name"`
 	Description string             `bson:"desc"`
 	Price       float32            `bson:"price"`
@@ -28,16 +34,80 @@ type Products struct {
 	Attributes  map[string]string  `bson:"attributes"`
 	Thumbnail   string             `bson:"thumbnail"`
 	Images      []string           `bson:"images"`
+	SKU         string             `bson:"sku,omitempty"`
+}
+
+// productIndexes are the indexes the products collection relies on: the text
+// index backs Search, category+price, tags+price and the attributes wildcard
+// back filtered listings, price and name back sorted ones and SKUs are unique
+// whenever they are set.
+var productIndexes = []datasource.MongoIndex{
+	{
+		Name:    "products_text",
+		Keys:    bson.D{{Key: "name", Value: "text"}, {Key: "desc", Value: "text"}, {Key: "tags", Value: "text"}},
+		Weights: bson.D{{Key: "name", Value: 10}, {Key: "tags", Value: 5}, {Key: "desc", Value: 1}},
+	},
+	{
+		Name: "products_category_price",
+		Keys: bson.D{{Key: "category", Value: 1}, {Key: "price", Value: 1}},
+	},
+	{
+		Name: "products_tags_price",
+		Keys: bson.D{{Key: "tags", Value: 1}, {Key: "price", Value: 1}},
+	},
+	{
+		Name: "products_attributes",
+		Keys: bson.D{{Key: "attributes.$**", Value: 1}},
+	},
+	{
+		Name: "products_price",
+		Keys: bson.D{{Key: "price", Value: 1}},
+	},
+	{
+		Name: "products_name",
+		Keys: bson.D{{Key: "name", Value: 1}},
+	},
+	{
+		Name:          "products_sku",
+		Keys:          bson.D{{Key: "sku", Value: 1}},
+		Unique:        true,
+		PartialFilter: bson.D{{Key: "sku", Value: bson.D{{Key: "$type", Value: "string"}}}},
+	},
+}
+
+// newProductDoc converts p to a document to insert, leaving the id to the
+// caller or the driver.
+func newProductDoc(p *biz.Product) Products {
+	product := Products{
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price,
+		Category:    p.Category,
+		Tags:        p.Tags,
+		Attributes:  p.Attributes,
+		Images:      p.Images,
+		SKU:         p.SKU,
+	}
+	if p.Thumbnail != nil {
+		product.Thumbnail = *p.Thumbnail
+	}
+	return product
+}
+
+// EnsureProductIndexes creates missing products indexes and logs drifted ones.
+func EnsureProductIndexes(ctx context.Context, db *mongo.Database, logger log.Logger) error {
+	return datasource.EnsureMongoIndexes(ctx, db.Collection("products"), logger, productIndexes)
 }
 
 type productsRepo struct {
-	db   *mongo.Database
-	log  *log.Helper
-	coll *mongo.Collection
-	tp   trace.Tracer
+	db          *mongo.Database
+	log         *log.Helper
+	coll        *mongo.Collection
+	suggestions *productSuggestions
+	tracer      trace.Tracer
 }
 
-func NewProductsRepo(data Data, logger log.Logger, tp trace.Tracer) (biz.ProductsRepo, error) {
+func NewProductsRepo(data Data, logger log.Logger, tracer trace.Tracer) (biz.ProductsRepo, error) {
 	m := data.GetMongoDB()
 	lg := log.NewHelper(logger)
 
@@ -46,69 +116,70 @@ func NewProductsRepo(data Data, logger log.Logger, tp trace.Tracer) (biz.Product
 		return nil, errors.InternalServer("MongoDB is not configured", "MongoDB is not configured")
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := EnsureProductIndexes(ctx, m, logger); err != nil {
+		lg.Errorf("failed to ensure products indexes: %s", err)
+	}
+	if rdb := data.GetRedis(); rdb != nil {
+		if err := EnsureProductSuggestions(ctx, m, rdb, logger); err != nil {
+			lg.Errorf("failed to build product suggestions: %s", err)
+		}
+	}
+
 	return &productsRepo{
-		db:   m,
-		log:  lg,
-		coll: m.Collection("products"),
-		tp:   tp,
+		db:          m,
+		log:         lg,
+		coll:        m.Collection("products"),
+		suggestions: newProductSuggestions(data.GetRedis(), logger),
+		tracer:      tracer,
 	}, nil
 }
 
-func (r productsRepo) Save(ctx context.Context, p *biz.Product) (string, error) {
-	_, span := otel.Tracer("products").Start(ctx, "Save")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "product",
-		Value: attribute.StringValue(fmt.Sprintf("Name: %s, Desc %s, Category: %s, Price: %f", p.Name, p.Description, p.Category, p.Price)),
-	})
-	product := Products{
-		Name:        p.Name,
-		Description: p.Description,
-		Price:       p.Price,
-		Category:    p.Category,
-		Tags:        p.Tags,
-		Attributes:  p.Attributes,
-		Images:      p.Images,
-	}
-	if p.Thumbnail != nil {
-		product.Thumbnail = *p.Thumbnail
-	}
-	res, err := r.coll.InsertOne(ctx, product)
+// startSpan starts a span of a products collection operation, op being the
+// mongo command.
+func (r productsRepo) startSpan(ctx context.Context, name, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return monitor.StartSpan(ctx, r.tracer, name, append([]attribute.KeyValue{
+		semconv.DBSystemMongoDB,
+		semconv.DBMongoDBCollection("products"),
+		semconv.DBOperation(op),
+	}, attrs...)...)
+}
+
+func (r productsRepo) Save(ctx context.Context, p *biz.Product) (_ string, err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.Save", "insert", p.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
+	doc := newProductDoc(p)
+	res, err := r.coll.InsertOne(ctx, doc)
 	if err != nil {
 		r.log.Error("failed to save product", err)
-		return "", err
+		return "", productError(err, "")
 	}
+	r.suggestions.add(ctx, doc)
 	id := res.InsertedID.(primitive.ObjectID).Hex()
 	return id, nil
 }
 
-func (r productsRepo) GetByID(ctx context.Context, id string) (*biz.Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "GetByID")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (r productsRepo) GetByID(ctx context.Context, id string) (_ *biz.Product, err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.GetByID", "find", biz.AttrProductID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 	idObj, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		r.log.Error("failed to parse product id", err)
-		return nil, err
+		return nil, productsV1.ErrorProductInvalidArgument("invalid product id %q", id)
 	}
 
 	res := r.coll.FindOne(ctx, bson.M{"_id": idObj})
 	if res.Err() != nil {
-		r.log.Error("failed to get product", res.Err())
-		return nil, res.Err()
-	}
-	if res.Err() != nil {
-		r.log.Error("failed to get product", res.Err())
-		return nil, res.Err()
+		if res.Err() != mongo.ErrNoDocuments {
+			r.log.Error("failed to get product", res.Err())
+		}
+		return nil, productError(res.Err(), id)
 	}
 	var p Products
 	err = res.Decode(&p)
 	if err != nil {
 		r.log.Error("failed to decode product", err)
-		return nil, err
+		return nil, productError(err, id)
 	}
 	return &biz.Product{
 		ID:          p.ID.Hex(),
@@ -120,16 +191,13 @@ func (r productsRepo) GetByID(ctx context.Context, id string) (*biz.Product, err
 		Attributes:  p.Attributes,
 		Thumbnail:   &p.Thumbnail,
 		Images:      p.Images,
+		SKU:         p.SKU,
 	}, nil
 }
 
-func (r productsRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*biz.Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "List")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", pagination.Page, pagination.Size)),
-	})
+func (r productsRepo) List(ctx context.Context, filter *biz.ProductFilter, order []biz.ProductOrder, pagination *biz.Pagination) (_ []*biz.Product, err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.List", "find", append(pagination.SpanAttributes(), filter.SpanAttributes()...)...)
+	defer func() { monitor.EndSpan(span, err) }()
 	offset := pagination.Page * pagination.Size
 	take := pagination.Size
 	if offset < 0 {
@@ -140,18 +208,18 @@ func (r productsRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*
 	}
 
 	r.log.Infof("ListProducts %d %d", offset, take)
-	opts := options.Find().SetSkip(int64(offset)).SetLimit(int64(take))
-	cur, err := r.coll.Find(ctx, bson.M{}, opts)
+	opts := options.Find().SetSkip(int64(offset)).SetLimit(int64(take)).SetSort(productSort(order))
+	cur, err := r.coll.Find(ctx, productFilter(filter), opts)
 	if err != nil {
 		r.log.Error("failed to list products", err)
-		return nil, err
+		return nil, productError(err, "")
 	}
 	var res []*biz.Product
 	for cur.Next(ctx) {
 		var p Products
 		if err := cur.Decode(&p); err != nil {
 			r.log.Error("failed to decode product", err)
-			return nil, err
+			return nil, productError(err, "")
 		}
 		res = append(res, &biz.Product{
 			ID:          p.ID.Hex(),
@@ -163,22 +231,18 @@ func (r productsRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*
 			Attributes:  p.Attributes,
 			Thumbnail:   &p.Thumbnail,
 			Images:      p.Images,
+			SKU:         p.SKU,
 		})
 	}
 	return res, nil
 }
 
-func (r productsRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "Update")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "product",
-		Value: attribute.StringValue(fmt.Sprintf("Name: %s, Desc %s, Category: %s, Price: %f", p.Name, p.Description, p.Category, p.Price)),
-	})
+func (r productsRepo) Update(ctx context.Context, p *biz.Product) (_, _ *biz.Product, err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.Update", "update", p.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 	uid, err := primitive.ObjectIDFromHex(p.ID)
 	if err != nil {
-		r.log.Error("failed to parse product id", err)
-		return nil, err
+		return nil, nil, productsV1.ErrorProductInvalidArgument("invalid product id %q", p.ID)
 	}
 	product := Products{
 		ID:          uid,
@@ -189,20 +253,24 @@ func (r productsRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product,
 		Tags:        p.Tags,
 		Attributes:  p.Attributes,
 		Images:      p.Images,
+		SKU:         p.SKU,
 	}
 	if p.Thumbnail != nil {
 		product.Thumbnail = *p.Thumbnail
 	}
-	res, err := r.coll.ReplaceOne(ctx, bson.M{"_id": product.ID}, product)
+	// the replaced document is returned, its names and tags leave the
+	// suggestions
+	var prev Products
+	err = r.coll.FindOneAndReplace(ctx, bson.M{"_id": product.ID}, product, options.FindOneAndReplace().SetReturnDocument(options.Before)).Decode(&prev)
 	if err != nil {
-		r.log.Error("failed to update product", err)
-		return nil, err
-	}
-	if res.ModifiedCount == 0 {
-		r.log.Error("failed to update product", "err was empty but insertions failed")
-		return nil, errors.InternalServer("failed to update product", "err was empty but insertions failed")
+		if err != mongo.ErrNoDocuments {
+			r.log.Error("failed to update product", err)
+		}
+		return nil, nil, productError(err, p.ID)
 	}
-	return &biz.Product{
+	r.suggestions.add(ctx, product)
+	r.suggestions.remove(ctx, prev)
+	updated := &biz.Product{
 		ID:          product.ID.Hex(),
 		Name:        product.Name,
 		Description: product.Description,
@@ -212,58 +280,429 @@ func (r productsRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product,
 		Attributes:  product.Attributes,
 		Thumbnail:   &product.Thumbnail,
 		Images:      product.Images,
+		SKU:         product.SKU,
+	}
+	return updated, &biz.Product{
+		ID:          prev.ID.Hex(),
+		Name:        prev.Name,
+		Description: prev.Description,
+		Price:       prev.Price,
+		Category:    prev.Category,
+		Tags:        prev.Tags,
+		Attributes:  prev.Attributes,
+		Thumbnail:   &prev.Thumbnail,
+		Images:      prev.Images,
+		SKU:         prev.SKU,
 	}, nil
 }
 
-func (r productsRepo) Delete(ctx context.Context, id string) (string, error) {
-	_, span := otel.Tracer("products").Start(ctx, "Delete")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (r productsRepo) Delete(ctx context.Context, id string) (_ string, err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.Delete", "delete", biz.AttrProductID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 	idObj, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		r.log.Error("failed to parse product id", err)
-		return "", err
+		return "", productsV1.ErrorProductInvalidArgument("invalid product id %q", id)
 	}
-	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
+	var prev Products
+	err = r.coll.FindOneAndDelete(ctx, bson.M{"_id": idObj}, options.FindOneAndDelete().SetProjection(bson.M{"name": 1, "tags": 1})).Decode(&prev)
 	if err != nil {
-		r.log.Error("failed to delete product", err)
-		return "", err
-	}
-	if res.DeletedCount == 0 {
-		r.log.Error("failed to delete product", "err was empty but insertions failed")
-		return "", errors.InternalServer("failed to delete product", "err was empty but insertions failed")
+		if err != mongo.ErrNoDocuments {
+			r.log.Error("failed to delete product", err)
+		}
+		return "", productError(err, id)
 	}
+	r.suggestions.remove(ctx, prev)
 	return id, nil
 }
 
-func (r productsRepo) Search(ctx context.Context, keyword string, pagination *biz.Pagination) ([]*biz.Product, error) {
-	_, span := otel.Tracer("products").Start(ctx, "Search")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "keyword",
-		Value: attribute.StringValue(keyword),
-	})
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", pagination.Page, pagination.Size)),
-	})
-	var products []Products
-	opts := options.Find().SetSkip(int64(pagination.Page * pagination.Size)).SetLimit(int64(pagination.Size))
-	cur, err := r.coll.Find(ctx, bson.M{"$text": bson.M{"$search": keyword}}, opts)
+// Facet sizes of searches: the most frequent searchFacetSize values of the
+// categories, tags and each attribute, of the searchFacetAttributes most
+// frequent attributes.
+const (
+	searchFacetSize       = 20
+	searchFacetAttributes = 10
+)
+
+// facetCount is a value of a facet, as $sortByCount counts it.
+type facetCount struct {
+	Value string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+// productSearchFacets is the result of the $facet stage of Search.
+type productSearchFacets struct {
+	Hits []struct {
+		Products `bson:",inline"`
+		Score    float64 `bson:"_score"`
+	} `bson:"hits"`
+	Total []struct {
+		N int64 `bson:"n"`
+	} `bson:"total"`
+	Categories []facetCount `bson:"categories"`
+	Tags       []facetCount `bson:"tags"`
+	Prices     []struct {
+		// the lower bound of the bucket, or "other"
+		Min   interface{} `bson:"_id"`
+		Count int64       `bson:"count"`
+	} `bson:"prices"`
+	Attributes []struct {
+		Key    string       `bson:"_id"`
+		Values []facetCount `bson:"values"`
+	} `bson:"attributes"`
+}
+
+// Search runs a single aggregation: the filter and the text query match the
+// products, then a $facet stage pages the hits by relevance and counts them
+// by category, tag, price bucket and attribute value.
+func (r productsRepo) Search(ctx context.Context, search *biz.ProductSearch, pagination *biz.Pagination) (_ *biz.ProductSearchResult, err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.Search", "aggregate", append(append(pagination.SpanAttributes(), biz.AttrSearchQuery.String(search.Query)), search.Filter.SpanAttributes()...)...)
+	defer func() { monitor.EndSpan(span, err) }()
+	match := productFilter(search.Filter)
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: match}}}
+	if search.Query != "" {
+		match["$text"] = bson.M{"$search": search.Query}
+		pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: bson.M{"_score": bson.M{"$meta": "textScore"}}}})
+	}
+	order := search.Order
+	if len(order) == 0 {
+		order = []biz.ProductOrder{{Field: biz.SortByRelevance}}
+	}
+	hits := bson.A{
+		bson.M{"$sort": productSort(order)},
+		bson.M{"$skip": max(0, pagination.Page*pagination.Size)},
+	}
+	if pagination.Size > 0 {
+		hits = append(hits, bson.M{"$limit": pagination.Size})
+	}
+	// prices beyond the last bound fall in the last bucket, the ones below
+	// the first bound in the "other" default bucket, left out of the facets
+	bounds := make(bson.A, 0, len(search.PriceBuckets)+1)
+	for _, b := range search.PriceBuckets {
+		bounds = append(bounds, float64(b))
+	}
+	bounds = append(bounds, math.Inf(1))
+	pipeline = append(pipeline, bson.D{{Key: "$facet", Value: bson.M{
+		"hits":  hits,
+		"total": bson.A{bson.M{"$count": "n"}},
+		"categories": bson.A{
+			bson.M{"$match": bson.M{"category": bson.M{"$nin": bson.A{"", nil}}}},
+			bson.M{"$sortByCount": "$category"},
+			bson.M{"$limit": searchFacetSize},
+		},
+		"tags": bson.A{
+			bson.M{"$unwind": "$tags"},
+			bson.M{"$sortByCount": "$tags"},
+			bson.M{"$limit": searchFacetSize},
+		},
+		"prices": bson.A{
+			bson.M{"$bucket": bson.M{"groupBy": "$price", "boundaries": bounds, "default": "other"}},
+		},
+		"attributes": bson.A{
+			bson.M{"$project": bson.M{"attr": bson.M{"$objectToArray": "$attributes"}}},
+			bson.M{"$unwind": "$attr"},
+			bson.M{"$group": bson.M{"_id": bson.M{"k": "$attr.k", "v": "$attr.v"}, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id.v", Value: 1}}},
+			bson.M{"$group": bson.M{
+				"_id":    "$_id.k",
+				"total":  bson.M{"$sum": "$count"},
+				"values": bson.M{"$push": bson.M{"_id": "$_id.v", "count": "$count"}},
+			}},
+			bson.M{"$sort": bson.D{{Key: "total", Value: -1}, {Key: "_id", Value: 1}}},
+			bson.M{"$limit": searchFacetAttributes},
+			bson.M{"$project": bson.M{"values": bson.M{"$slice": bson.A{"$values", searchFacetSize}}}},
+		},
+	}}})
+
+	cur, err := r.coll.Aggregate(ctx, pipeline)
 	if err != nil {
 		r.log.Error("failed to search products", err)
-		return nil, err
+		return nil, productError(err, "")
 	}
-	if err := cur.All(ctx, &products); err != nil {
+	var docs []productSearchFacets
+	if err := cur.All(ctx, &docs); err != nil {
 		r.log.Error("failed to decode products", err)
+		return nil, productError(err, "")
+	}
+	res := &biz.ProductSearchResult{}
+	if len(docs) == 0 {
+		return res, nil
+	}
+	doc := docs[0]
+	for _, h := range doc.Hits {
+		p := h.Products
+		res.Hits = append(res.Hits, &biz.SearchHit{
+			Product: &biz.Product{
+				ID:          p.ID.Hex(),
+				Name:        p.Name,
+				Description: p.Description,
+				Price:       p.Price,
+				Category:    p.Category,
+				Tags:        p.Tags,
+				Attributes:  p.Attributes,
+				Thumbnail:   &p.Thumbnail,
+				Images:      p.Images,
+				SKU:         p.SKU,
+			},
+			Score: h.Score,
+		})
+	}
+	if len(doc.Total) > 0 {
+		res.Total = doc.Total[0].N
+	}
+	res.Facets.Categories = facetCounts(doc.Categories)
+	res.Facets.Tags = facetCounts(doc.Tags)
+	for _, b := range doc.Prices {
+		lower, ok := b.Min.(float64)
+		if !ok {
+			continue
+		}
+		bucket := biz.PriceBucket{Min: float32(lower), Count: b.Count}
+		if i := slices.Index(search.PriceBuckets, bucket.Min); i >= 0 && i+1 < len(search.PriceBuckets) {
+			bucket.Max = &search.PriceBuckets[i+1]
+		}
+		res.Facets.Prices = append(res.Facets.Prices, bucket)
+	}
+	for _, a := range doc.Attributes {
+		res.Facets.Attributes = append(res.Facets.Attributes, biz.AttributeFacet{Key: a.Key, Values: facetCounts(a.Values)})
+	}
+	return res, nil
+}
+
+func facetCounts(docs []facetCount) []biz.FacetCount {
+	res := make([]biz.FacetCount, 0, len(docs))
+	for _, d := range docs {
+		res = append(res, biz.FacetCount{Value: d.Value, Count: d.Count})
+	}
+	return res
+}
+
+// withTransaction runs fn in a MongoDB transaction, which needs a replica set
+// or a sharded cluster.
+func (r productsRepo) withTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	sess, err := r.db.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer sess.EndSession(ctx)
+	_, err = sess.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+func (r productsRepo) SaveMany(ctx context.Context, ps []*biz.Product, atomic bool) (_ []biz.BatchItem[string], err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.SaveMany", "insert", biz.AttrBatchSize.Int(len(ps)), biz.AttrBatchAtomic.Bool(atomic))
+	defer func() { monitor.EndSpan(span, err) }()
+	items := make([]biz.BatchItem[string], len(ps))
+	docs := make([]interface{}, len(ps))
+	for i, p := range ps {
+		product := newProductDoc(p)
+		product.ID = primitive.NewObjectID()
+		items[i].Value = product.ID.Hex()
+		docs[i] = product
+	}
+	// unordered inserts go on past failed documents, ordered ones stop at
+	// the first failure, which aborts the transaction
+	insert := func(ctx context.Context) error {
+		_, err := r.coll.InsertMany(ctx, docs, options.InsertMany().SetOrdered(atomic))
+		return err
+	}
+	if atomic {
+		err = r.withTransaction(ctx, insert)
+	} else {
+		err = insert(ctx)
+	}
+	var bwe mongo.BulkWriteException
+	if errors.As(err, &bwe) && bwe.WriteConcernError == nil && len(bwe.WriteErrors) > 0 {
+		for _, we := range bwe.WriteErrors {
+			items[we.Index] = biz.BatchItem[string]{Err: productError(we.WriteError, "")}
+		}
+		err = nil
+	}
+	if err != nil {
+		r.log.Error("failed to save products", err)
+		return nil, productError(err, "")
+	}
+	if atomic {
+		if err := biz.FirstError(items); err != nil {
+			return nil, err
+		}
+	}
+	saved := make([]Products, 0, len(docs))
+	for i, item := range items {
+		if item.Err == nil {
+			saved = append(saved, docs[i].(Products))
+		}
+	}
+	r.suggestions.add(ctx, saved...)
+	return items, nil
+}
+
+func (r productsRepo) DeleteMany(ctx context.Context, ids []string, atomic bool) (_ []biz.BatchItem[string], err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.DeleteMany", "delete", biz.AttrBatchSize.Int(len(ids)), biz.AttrBatchAtomic.Bool(atomic))
+	defer func() { monitor.EndSpan(span, err) }()
+	items := make([]biz.BatchItem[string], len(ids))
+	oids := make([]primitive.ObjectID, len(ids))
+	valid := make([]primitive.ObjectID, 0, len(ids))
+	for i, id := range ids {
+		items[i].Value = id
+		oid, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			items[i].Err = productsV1.ErrorProductInvalidArgument("invalid product id %q", id)
+			continue
+		}
+		oids[i] = oid
+		valid = append(valid, oid)
+	}
+	if atomic {
+		if err := biz.FirstError(items); err != nil {
+			return nil, err
+		}
+	}
+
+	var existing map[primitive.ObjectID]Products
+	del := func(ctx context.Context) (err error) {
+		existing, err = r.existing(ctx, valid)
+		if err != nil {
+			return err
+		}
+		for i, oid := range oids {
+			if _, ok := existing[oid]; items[i].Err == nil && !ok {
+				items[i].Err = productsV1.ErrorProductNotFound("product %s not found", ids[i])
+			}
+		}
+		if atomic {
+			if err := biz.FirstError(items); err != nil {
+				return err
+			}
+		}
+		_, err = r.coll.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": valid}})
+		return err
+	}
+	if atomic {
+		err = r.withTransaction(ctx, del)
+	} else {
+		err = del(ctx)
+	}
+	if err != nil {
+		if errors.FromError(err).Reason == "" {
+			r.log.Error("failed to delete products", err)
+		}
+		return nil, productError(err, "")
+	}
+	deleted := make([]Products, 0, len(existing))
+	for i, item := range items {
+		if item.Err == nil {
+			deleted = append(deleted, existing[oids[i]])
+		}
+	}
+	r.suggestions.remove(ctx, deleted...)
+	return items, nil
+}
+
+// existing returns the names and tags of the stored products of oids.
+func (r productsRepo) existing(ctx context.Context, oids []primitive.ObjectID) (map[primitive.ObjectID]Products, error) {
+	cur, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": oids}}, options.Find().SetProjection(bson.M{"name": 1, "tags": 1}))
+	if err != nil {
 		return nil, err
 	}
-	var res []*biz.Product
-	for _, p := range products {
-		res = append(res, &biz.Product{
+	var docs []Products
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	existing := make(map[primitive.ObjectID]Products, len(docs))
+	for _, d := range docs {
+		existing[d.ID] = d
+	}
+	return existing, nil
+}
+
+// productFilter translates f to a products collection query.
+func productFilter(f *biz.ProductFilter) bson.M {
+	query := bson.M{}
+	if f.Category != nil {
+		query["category"] = *f.Category
+	}
+	switch {
+	case len(f.Tags) == 1:
+		query["tags"] = f.Tags[0]
+	case len(f.Tags) > 1 && f.AllTags:
+		query["tags"] = bson.M{"$all": f.Tags}
+	case len(f.Tags) > 1:
+		query["tags"] = bson.M{"$in": f.Tags}
+	}
+	price := bson.M{}
+	if f.MinPrice != nil {
+		price["$gte"] = *f.MinPrice
+	}
+	if f.MaxPrice != nil {
+		price["$lte"] = *f.MaxPrice
+	}
+	if len(price) > 0 {
+		query["price"] = price
+	}
+	for k, v := range f.Attributes {
+		query["attributes."+k] = v
+	}
+	// products without a thumbnail store an empty one
+	if f.HasThumbnail != nil {
+		if *f.HasThumbnail {
+			query["thumbnail"] = bson.M{"$gt": ""}
+		} else {
+			query["thumbnail"] = bson.M{"$in": bson.A{"", nil}}
+		}
+	}
+	return query
+}
+
+// productSortKeys are the document fields of the sort fields, ids growing
+// with the creation time. The text score only exists in searches.
+var productSortKeys = map[biz.ProductSortField]string{
+	biz.SortByPrice:     "price",
+	biz.SortByName:      "name",
+	biz.SortByCreatedAt: "_id",
+	biz.SortByRelevance: "_score",
+}
+
+// productSort translates order to a sort document, ending with the id so
+// that pages of equal products are stable.
+func productSort(order []biz.ProductOrder) bson.D {
+	sort := make(bson.D, 0, len(order)+1)
+	seen := make(map[string]bool, len(order)+1)
+	for _, o := range order {
+		key, ok := productSortKeys[o.Field]
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		dir := 1
+		if o.Desc || o.Field == biz.SortByRelevance {
+			dir = -1
+		}
+		sort = append(sort, bson.E{Key: key, Value: dir})
+	}
+	if !seen["_id"] {
+		sort = append(sort, bson.E{Key: "_id", Value: 1})
+	}
+	return sort
+}
+
+func (r productsRepo) Stream(ctx context.Context, filter *biz.ProductFilter, fn func(*biz.Product) error) (err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.Stream", "find", filter.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
+	cur, err := r.coll.Find(ctx, productFilter(filter), options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		r.log.Error("failed to export products", err)
+		return productError(err, "")
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var p Products
+		if err := cur.Decode(&p); err != nil {
+			r.log.Error("failed to decode product", err)
+			return productError(err, "")
+		}
+		err = fn(&biz.Product{
 			ID:          p.ID.Hex(),
 			Name:        p.Name,
 			Description: p.Description,
@@ -273,7 +712,106 @@ func (r productsRepo) Search(ctx context.Context, keyword string, pagination *bi
 			Attributes:  p.Attributes,
 			Thumbnail:   &p.Thumbnail,
 			Images:      p.Images,
+			SKU:         p.SKU,
 		})
+		if err != nil {
+			return err
+		}
 	}
-	return res, nil
+	return productError(cur.Err(), "")
+}
+
+// productChangeTypes maps the change stream operations Watch follows.
+var productChangeTypes = map[string]biz.ChangeType{
+	"insert":  biz.ChangeCreated,
+	"update":  biz.ChangeUpdated,
+	"replace": biz.ChangeUpdated,
+	"delete":  biz.ChangeDeleted,
+}
+
+// productChangeEvent is the part of a change stream event Watch reads.
+type productChangeEvent struct {
+	OperationType string              `bson:"operationType"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	DocumentKey   struct {
+		ID primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument *Products `bson:"fullDocument"`
+}
+
+// Watch follows a change stream of the products collection, which needs a
+// replica set. Resume tokens are the change stream tokens, base64 encoded;
+// they stay valid as long as the oplog holds their change.
+func (r productsRepo) Watch(ctx context.Context, resumeToken string, fn func(*biz.Change[*biz.Product]) error) (err error) {
+	ctx, span := r.startSpan(ctx, "productsRepo.Watch", "aggregate")
+	defer func() { monitor.EndSpan(span, err) }()
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(resumeToken)
+		if err != nil || bson.Raw(raw).Validate() != nil {
+			return productsV1.ErrorProductInvalidArgument("invalid resume token %q", resumeToken)
+		}
+		opts.SetStartAfter(bson.Raw(raw))
+	}
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{
+		"operationType": bson.M{"$in": []string{"insert", "update", "replace", "delete"}},
+	}}}}
+	cs, err := r.coll.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return r.watchError(err, resumeToken)
+	}
+	defer cs.Close(context.WithoutCancel(ctx))
+	for cs.Next(ctx) {
+		var ev productChangeEvent
+		if err := cs.Decode(&ev); err != nil {
+			r.log.Error("failed to decode product change", err)
+			return productError(err, "")
+		}
+		change := &biz.Change[*biz.Product]{
+			Type:        productChangeTypes[ev.OperationType],
+			ID:          ev.DocumentKey.ID.Hex(),
+			Time:        time.Unix(int64(ev.ClusterTime.T), 0).UTC(),
+			ResumeToken: base64.RawURLEncoding.EncodeToString(cs.ResumeToken()),
+		}
+		// updates carry the current document, unset when it was deleted since
+		if p := ev.FullDocument; p != nil && change.Type != biz.ChangeDeleted {
+			change.Value = &biz.Product{
+				ID:          p.ID.Hex(),
+				Name:        p.Name,
+				Description: p.Description,
+				Price:       p.Price,
+				Category:    p.Category,
+				Tags:        p.Tags,
+				Attributes:  p.Attributes,
+				Thumbnail:   &p.Thumbnail,
+				Images:      p.Images,
+				SKU:         p.SKU,
+			}
+		}
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return r.watchError(cs.Err(), resumeToken)
+}
+
+// watchError maps the change stream failures a client can act upon.
+func (r productsRepo) watchError(err error, resumeToken string) error {
+	if err == nil {
+		return nil
+	}
+	var se mongo.ServerError
+	if errors.As(err, &se) {
+		switch {
+		case se.HasErrorCode(mongoErrNotReplicaSet):
+			return productsV1.ErrorProductUnavailable("watching products needs a MongoDB replica set")
+		case se.HasErrorCode(mongoErrHistoryLost):
+			return productsV1.ErrorProductInvalidArgument("resume token %s expired, list the products again", resumeToken)
+		}
+	}
+	r.log.Error("failed to watch products", err)
+	return productError(err, "")
 }
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+
+	"layout/internal/biz"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestProductFilter(t *testing.T) {
+	category := "lamps"
+	minPrice, maxPrice := float32(10), float32(50)
+	yes, no := true, false
+	tests := []struct {
+		name   string
+		filter biz.ProductFilter
+		want   bson.M
+	}{
+		{
+			name: "empty",
+			want: bson.M{},
+		},
+		{
+			name:   "category",
+			filter: biz.ProductFilter{Category: &category},
+			want:   bson.M{"category": "lamps"},
+		},
+		{
+			name:   "single tag",
+			filter: biz.ProductFilter{Tags: []string{"desk"}, AllTags: true},
+			want:   bson.M{"tags": "desk"},
+		},
+		{
+			name:   "any tag",
+			filter: biz.ProductFilter{Tags: []string{"desk", "led"}},
+			want:   bson.M{"tags": bson.M{"$in": []string{"desk", "led"}}},
+		},
+		{
+			name:   "all tags",
+			filter: biz.ProductFilter{Tags: []string{"desk", "led"}, AllTags: true},
+			want:   bson.M{"tags": bson.M{"$all": []string{"desk", "led"}}},
+		},
+		{
+			name:   "min price",
+			filter: biz.ProductFilter{MinPrice: &minPrice},
+			want:   bson.M{"price": bson.M{"$gte": float32(10)}},
+		},
+		{
+			name:   "price range",
+			filter: biz.ProductFilter{MinPrice: &minPrice, MaxPrice: &maxPrice},
+			want:   bson.M{"price": bson.M{"$gte": float32(10), "$lte": float32(50)}},
+		},
+		{
+			name:   "attributes",
+			filter: biz.ProductFilter{Attributes: map[string]string{"color": "red", "size": "m"}},
+			want:   bson.M{"attributes.color": "red", "attributes.size": "m"},
+		},
+		{
+			name:   "with thumbnail",
+			filter: biz.ProductFilter{HasThumbnail: &yes},
+			want:   bson.M{"thumbnail": bson.M{"$gt": ""}},
+		},
+		{
+			name:   "without thumbnail",
+			filter: biz.ProductFilter{HasThumbnail: &no},
+			want:   bson.M{"thumbnail": bson.M{"$in": bson.A{"", nil}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := productFilter(&tt.filter); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("productFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProductSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		order []biz.ProductOrder
+		want  bson.D
+	}{
+		{
+			name: "default",
+			want: bson.D{{Key: "_id", Value: 1}},
+		},
+		{
+			name:  "price descending",
+			order: []biz.ProductOrder{{Field: biz.SortByPrice, Desc: true}},
+			want:  bson.D{{Key: "price", Value: -1}, {Key: "_id", Value: 1}},
+		},
+		{
+			name:  "several keys",
+			order: []biz.ProductOrder{{Field: biz.SortByName}, {Field: biz.SortByPrice, Desc: true}},
+			want:  bson.D{{Key: "name", Value: 1}, {Key: "price", Value: -1}, {Key: "_id", Value: 1}},
+		},
+		{
+			name:  "newest first",
+			order: []biz.ProductOrder{{Field: biz.SortByCreatedAt, Desc: true}},
+			want:  bson.D{{Key: "_id", Value: -1}},
+		},
+		{
+			name:  "relevance is descending",
+			order: []biz.ProductOrder{{Field: biz.SortByRelevance}},
+			want:  bson.D{{Key: "_score", Value: -1}, {Key: "_id", Value: 1}},
+		},
+		{
+			name:  "repeated and unknown keys",
+			order: []biz.ProductOrder{{Field: biz.SortByName}, {Field: "color"}, {Field: biz.SortByName, Desc: true}},
+			want:  bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := productSort(tt.order); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("productSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
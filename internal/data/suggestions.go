@@ -0,0 +1,369 @@
+package data
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	productsV1 "layout/api/products/v1"
+	"layout/internal/biz"
+)
+
+// The completions of product names and tags live in redis:
+//   - suggestions:products is a sorted set of <text>\x00<kind>\x00<display>
+//     members, all scored 0 so that ZRANGEBYLEX finds the ones starting with
+//     a prefix. text is lower case, names are indexed from each of their
+//     first suggestionWordStarts words;
+//   - suggestions:products:refs counts the products of each member, which is
+//     dropped along with its last product;
+//   - suggestions:products:searches counts the searches of each text;
+//   - suggestions:products:searches:<prefix> counts them again under each
+//     prefix of the text, up to suggestionPrefixLen bytes, so that the most
+//     searched completions of a prefix are found without reading them all.
+const (
+	suggestionsKey        = "suggestions:products"
+	suggestionRefsKey     = suggestionsKey + ":refs"
+	suggestionSearchesKey = suggestionsKey + ":searches"
+	suggestionPrefixesKey = suggestionSearchesKey + ":"
+	suggestionWordStarts  = 4
+	suggestionPrefixLen   = 20
+	// suggestionCandidates bounds the members of a prefix ranked by Suggest,
+	// on top of its most searched ones.
+	suggestionCandidates = 200
+)
+
+// suggestionRefsScript adds ARGV[1] to the product counts of the members
+// ARGV[2:], adding them to or removing them from the completions.
+var suggestionRefsScript = redis.NewScript(`
+for i = 2, #ARGV do
+	local n = redis.call('HINCRBY', KEYS[2], ARGV[i], ARGV[1])
+	if n > 0 then
+		redis.call('ZADD', KEYS[1], 0, ARGV[i])
+	else
+		redis.call('HDEL', KEYS[2], ARGV[i])
+		redis.call('ZREM', KEYS[1], ARGV[i])
+	end
+end
+return 0
+`)
+
+// productSuggestions maintains and reads the completions index. It is a
+// no-op without redis, Suggest being unavailable.
+type productSuggestions struct {
+	rdb *redis.Client
+	log *log.Helper
+}
+
+func newProductSuggestions(rdb *redis.Client, logger log.Logger) *productSuggestions {
+	return &productSuggestions{rdb: rdb, log: log.NewHelper(logger)}
+}
+
+func NewProductSuggestionsRepo(data Data, logger log.Logger) biz.ProductSuggestionsRepo {
+	s := newProductSuggestions(data.GetRedis(), logger)
+	if s.rdb == nil {
+		s.log.Warn("No Redis client found, product suggestions are unavailable")
+	}
+	return s
+}
+
+// normalizeSuggestion lower cases s and collapses its spaces.
+func normalizeSuggestion(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// suggestionMembers returns the completions index members of a product.
+func suggestionMembers(p Products) []string {
+	seen := map[string]bool{}
+	var members []string
+	add := func(text string, kind biz.SuggestionKind, display string) {
+		m := text + "\x00" + string(kind) + "\x00" + display
+		if text != "" && !seen[m] {
+			seen[m] = true
+			members = append(members, m)
+		}
+	}
+	words := strings.Fields(p.Name)
+	name := strings.Join(words, " ")
+	for i := 0; i < len(words) && i < suggestionWordStarts; i++ {
+		add(normalizeSuggestion(strings.Join(words[i:], " ")), biz.SuggestionName, name)
+	}
+	for _, t := range p.Tags {
+		add(normalizeSuggestion(t), biz.SuggestionTag, strings.TrimSpace(t))
+	}
+	return members
+}
+
+// truncatePrefix cuts text to at most suggestionPrefixLen bytes, on a rune
+// boundary.
+func truncatePrefix(text string) string {
+	if len(text) <= suggestionPrefixLen {
+		return text
+	}
+	cut := suggestionPrefixLen
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut]
+}
+
+// searchPrefixes returns the prefixes of text whose searches are counted,
+// shortest first.
+func searchPrefixes(text string) []string {
+	text = truncatePrefix(text)
+	var prefixes []string
+	for i := range text {
+		if i > 0 {
+			prefixes = append(prefixes, text[:i])
+		}
+	}
+	if text != "" {
+		prefixes = append(prefixes, text)
+	}
+	return prefixes
+}
+
+// update adds delta to the product counts of the members of ps.
+func (s *productSuggestions) update(ctx context.Context, rdb redis.Scripter, keys []string, delta int, ps ...Products) error {
+	args := []interface{}{delta}
+	for _, p := range ps {
+		for _, m := range suggestionMembers(p) {
+			args = append(args, m)
+		}
+	}
+	if len(args) == 1 {
+		return nil
+	}
+	return suggestionRefsScript.Run(ctx, rdb, keys, args...).Err()
+}
+
+// add indexes the names and tags of ps. Failures only leave the index stale,
+// so they are logged.
+func (s *productSuggestions) add(ctx context.Context, ps ...Products) {
+	if s.rdb == nil {
+		return
+	}
+	if err := s.update(ctx, s.rdb, []string{suggestionsKey, suggestionRefsKey}, 1, ps...); err != nil {
+		s.log.Warnf("REDIS: failed to index product suggestions: %s", err)
+	}
+}
+
+// remove drops the names and tags ps were the last products of.
+func (s *productSuggestions) remove(ctx context.Context, ps ...Products) {
+	if s.rdb == nil {
+		return
+	}
+	if err := s.update(ctx, s.rdb, []string{suggestionsKey, suggestionRefsKey}, -1, ps...); err != nil {
+		s.log.Warnf("REDIS: failed to unindex product suggestions: %s", err)
+	}
+}
+
+// suggestionCandidate is a completion of a prefix with its ranking.
+type suggestionCandidate struct {
+	biz.Suggestion
+	searches float64
+	products int64
+}
+
+// Suggest ranks the completions of prefix by searches, then by products
+// and length. The candidates are the most searched completions of prefix
+// and the first suggestionCandidates in lexical order.
+func (s *productSuggestions) Suggest(ctx context.Context, prefix string, limit int) ([]biz.Suggestion, error) {
+	if s.rdb == nil {
+		return nil, productsV1.ErrorProductUnavailable("product suggestions need Redis")
+	}
+	text := normalizeSuggestion(prefix)
+	searched, err := s.rdb.ZRevRange(ctx, suggestionPrefixesKey+truncatePrefix(text), 0, suggestionCandidates-1).Result()
+	if err != nil {
+		s.log.Errorf("REDIS: failed to read the searches of %q: %s", text, err)
+		return nil, productsV1.ErrorProductInternal("product suggestions failure").WithCause(err)
+	}
+	pipe := s.rdb.Pipeline()
+	var ranges []*redis.StringSliceCmd
+	for _, t := range searched {
+		// prefixes longer than suggestionPrefixLen share their counts
+		if strings.HasPrefix(t, text) {
+			ranges = append(ranges, pipe.ZRangeByLex(ctx, suggestionsKey, &redis.ZRangeBy{
+				Min: "[" + t + "\x00",
+				Max: "[" + t + "\x00\xff",
+			}))
+		}
+	}
+	ranges = append(ranges, pipe.ZRangeByLex(ctx, suggestionsKey, &redis.ZRangeBy{
+		Min:   "[" + text,
+		Max:   "[" + text + "\xff",
+		Count: suggestionCandidates,
+	}))
+	if _, err := pipe.Exec(ctx); err != nil {
+		s.log.Errorf("REDIS: failed to read %s: %s", suggestionsKey, err)
+		return nil, productsV1.ErrorProductInternal("product suggestions failure").WithCause(err)
+	}
+	seen := map[string]bool{}
+	var members []string
+	for _, r := range ranges {
+		for _, m := range r.Val() {
+			if !seen[m] {
+				seen[m] = true
+				members = append(members, m)
+			}
+		}
+	}
+	if len(members) == 0 {
+		return []biz.Suggestion{}, nil
+	}
+	texts := make([]string, len(members))
+	for i, m := range members {
+		texts[i], _, _ = strings.Cut(m, "\x00")
+	}
+	pipe = s.rdb.Pipeline()
+	searches := pipe.ZMScore(ctx, suggestionSearchesKey, texts...)
+	refs := pipe.HMGet(ctx, suggestionRefsKey, members...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		s.log.Errorf("REDIS: failed to rank suggestions: %s", err)
+		return nil, productsV1.ErrorProductInternal("product suggestions failure").WithCause(err)
+	}
+	return rankSuggestions(members, searches.Val(), refs.Val(), limit), nil
+}
+
+// rankSuggestions returns the limit best completions among members, given
+// the searches of their text and their product counts.
+func rankSuggestions(members []string, searches []float64, refs []interface{}, limit int) []biz.Suggestion {
+	// a name indexed from several of its words keeps its best ranking
+	byDisplay := map[string]*suggestionCandidate{}
+	var candidates []*suggestionCandidate
+	for i, m := range members {
+		parts := strings.SplitN(m, "\x00", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		c := &suggestionCandidate{
+			Suggestion: biz.Suggestion{Text: parts[2], Kind: biz.SuggestionKind(parts[1])},
+			searches:   searches[i],
+		}
+		if n, ok := refs[i].(string); ok {
+			c.products, _ = strconv.ParseInt(n, 10, 64)
+		}
+		key := parts[1] + "\x00" + strings.ToLower(parts[2])
+		if prev, ok := byDisplay[key]; ok {
+			prev.searches = max(prev.searches, c.searches)
+			prev.products = max(prev.products, c.products)
+			continue
+		}
+		byDisplay[key] = c
+		candidates = append(candidates, c)
+	}
+	slices.SortFunc(candidates, func(a, b *suggestionCandidate) int {
+		return cmp.Or(
+			cmp.Compare(b.searches, a.searches),
+			cmp.Compare(b.products, a.products),
+			cmp.Compare(len(a.Text), len(b.Text)),
+			strings.Compare(a.Text, b.Text),
+		)
+	})
+	res := make([]biz.Suggestion, 0, min(limit, len(candidates)))
+	for _, c := range candidates[:min(limit, len(candidates))] {
+		res = append(res, c.Suggestion)
+	}
+	return res
+}
+
+// RecordSearch counts query when it is the text of a completion, which keeps
+// the searches counted bounded by the index.
+func (s *productSuggestions) RecordSearch(ctx context.Context, query string) error {
+	text := normalizeSuggestion(query)
+	if s.rdb == nil || text == "" {
+		return nil
+	}
+	found, err := s.rdb.ZRangeByLex(ctx, suggestionsKey, &redis.ZRangeBy{
+		Min:   "[" + text + "\x00",
+		Max:   "[" + text + "\x00\xff",
+		Count: 1,
+	}).Result()
+	if err != nil || len(found) == 0 {
+		return err
+	}
+	_, err = s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZIncrBy(ctx, suggestionSearchesKey, 1, text)
+		for _, p := range searchPrefixes(text) {
+			pipe.ZIncrBy(ctx, suggestionPrefixesKey+p, 1, text)
+		}
+		return nil
+	})
+	return err
+}
+
+// RebuildProductSuggestions indexes the names and tags of every product in
+// fresh keys and swaps them with the completions index. Products written
+// meanwhile are only indexed by the next rebuild; search counts are kept.
+func RebuildProductSuggestions(ctx context.Context, db *mongo.Database, rdb *redis.Client, logger log.Logger) error {
+	s := newProductSuggestions(rdb, logger)
+	keys := []string{suggestionsKey + ":rebuild", suggestionRefsKey + ":rebuild"}
+	if err := rdb.Del(ctx, keys...).Err(); err != nil {
+		return err
+	}
+	cur, err := db.Collection("products").Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"name": 1, "tags": 1}))
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+	const chunk = 500
+	batch := make([]Products, 0, chunk)
+	flush := func() error {
+		err := s.update(ctx, rdb, keys, 1, batch...)
+		batch = batch[:0]
+		return err
+	}
+	count := 0
+	for cur.Next(ctx) {
+		var p Products
+		if err := cur.Decode(&p); err != nil {
+			return err
+		}
+		count++
+		if batch = append(batch, p); len(batch) == chunk {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	// a catalogue without names nor tags leaves no fresh keys
+	indexed, err := rdb.Exists(ctx, keys[0]).Result()
+	if err != nil {
+		return err
+	}
+	pipe := rdb.TxPipeline()
+	pipe.Del(ctx, suggestionsKey, suggestionRefsKey)
+	if indexed > 0 {
+		pipe.Rename(ctx, keys[0], suggestionsKey)
+		pipe.Rename(ctx, keys[1], suggestionRefsKey)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	s.log.Infof("REDIS: indexed the suggestions of %d products", count)
+	return nil
+}
+
+// EnsureProductSuggestions builds the completions index when it is missing,
+// e.g. on the first start with a catalogue.
+func EnsureProductSuggestions(ctx context.Context, db *mongo.Database, rdb *redis.Client, logger log.Logger) error {
+	n, err := rdb.Exists(ctx, suggestionsKey).Result()
+	if err != nil || n > 0 {
+		return err
+	}
+	return RebuildProductSuggestions(ctx, db, rdb, logger)
+}
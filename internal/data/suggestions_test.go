@@ -0,0 +1,165 @@
+package data
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"layout/internal/biz"
+)
+
+func TestNormalizeSuggestion(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Desk Lamp", "desk lamp"},
+		{"  Desk \t  LAMP ", "desk lamp"},
+		{"   ", ""},
+		{"Éclair", "éclair"},
+	}
+	for _, tt := range tests {
+		if got := normalizeSuggestion(tt.in); got != tt.want {
+			t.Errorf("normalizeSuggestion(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestionMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		product Products
+		want    []string
+	}{
+		{
+			name:    "empty",
+			product: Products{},
+		},
+		{
+			name:    "name words and tags",
+			product: Products{Name: " Trail  Running Shoes", Tags: []string{"Outdoor ", "running shoes"}},
+			want: []string{
+				"trail running shoes\x00name\x00Trail Running Shoes",
+				"running shoes\x00name\x00Trail Running Shoes",
+				"shoes\x00name\x00Trail Running Shoes",
+				"outdoor\x00tag\x00Outdoor",
+				"running shoes\x00tag\x00running shoes",
+			},
+		},
+		{
+			name:    "first words only",
+			product: Products{Name: "a b c d e"},
+			want: []string{
+				"a b c d e\x00name\x00a b c d e",
+				"b c d e\x00name\x00a b c d e",
+				"c d e\x00name\x00a b c d e",
+				"d e\x00name\x00a b c d e",
+			},
+		},
+		{
+			name:    "duplicates and blank tags",
+			product: Products{Name: "Mug", Tags: []string{"mug", "mug", " "}},
+			want: []string{
+				"mug\x00name\x00Mug",
+				"mug\x00tag\x00mug",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suggestionMembers(tt.product); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("suggestionMembers() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPrefixes(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"mug", []string{"m", "mu", "mug"}},
+		{"été", []string{"é", "ét", "été"}},
+	}
+	for _, tt := range tests {
+		if got := searchPrefixes(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("searchPrefixes(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	long := strings.Repeat("a", suggestionPrefixLen-1) + "éé"
+	got := searchPrefixes(long)
+	if last := got[len(got)-1]; last != long[:suggestionPrefixLen-1] {
+		t.Errorf("longest prefix of %q = %q, want it cut before a split rune", long, last)
+	}
+}
+
+func TestRankSuggestions(t *testing.T) {
+	member := func(text string, kind biz.SuggestionKind, display string) string {
+		return text + "\x00" + string(kind) + "\x00" + display
+	}
+	name := func(text string) biz.Suggestion { return biz.Suggestion{Text: text, Kind: biz.SuggestionName} }
+	tag := func(text string) biz.Suggestion { return biz.Suggestion{Text: text, Kind: biz.SuggestionTag} }
+	tests := []struct {
+		name     string
+		members  []string
+		searches []float64
+		refs     []interface{}
+		limit    int
+		want     []biz.Suggestion
+	}{
+		{
+			name:     "searches first",
+			members:  []string{member("run", biz.SuggestionTag, "run"), member("running", biz.SuggestionTag, "running")},
+			searches: []float64{1, 5},
+			refs:     []interface{}{"10", "1"},
+			limit:    5,
+			want:     []biz.Suggestion{tag("running"), tag("run")},
+		},
+		{
+			name:     "then products and length",
+			members:  []string{member("runner", biz.SuggestionTag, "runner"), member("run", biz.SuggestionTag, "run"), member("rug", biz.SuggestionName, "Rug")},
+			searches: []float64{0, 0, 0},
+			refs:     []interface{}{"3", "3", "7"},
+			limit:    5,
+			want:     []biz.Suggestion{name("Rug"), tag("run"), tag("runner")},
+		},
+		{
+			name: "a name keeps its best ranking",
+			members: []string{
+				member("running shoes", biz.SuggestionName, "Trail Running Shoes"),
+				member("rug", biz.SuggestionName, "Rug"),
+				member("running shoes", biz.SuggestionTag, "running shoes"),
+			},
+			searches: []float64{4, 2, 0},
+			refs:     []interface{}{"1", "9", "2"},
+			limit:    5,
+			want:     []biz.Suggestion{name("Trail Running Shoes"), name("Rug"), tag("running shoes")},
+		},
+		{
+			name:     "limited",
+			members:  []string{member("a", biz.SuggestionTag, "a"), member("b", biz.SuggestionTag, "b")},
+			searches: []float64{0, 1},
+			refs:     []interface{}{nil, nil},
+			limit:    1,
+			want:     []biz.Suggestion{tag("b")},
+		},
+		{
+			name:     "malformed members skipped",
+			members:  []string{"mug", member("mug", biz.SuggestionTag, "mug")},
+			searches: []float64{0, 0},
+			refs:     []interface{}{nil, "1"},
+			limit:    5,
+			want:     []biz.Suggestion{tag("mug")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rankSuggestions(tt.members, tt.searches, tt.refs, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rankSuggestions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
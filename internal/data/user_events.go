@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	usersV1 "layout/api/users/v1"
+	"layout/internal/biz"
+	"layout/pkg/monitor"
+)
+
+const (
+	// userEventsStream keeps the changes of users, published on
+	// users.<created|updated|deleted>, for userEventsMaxAge. Resume tokens
+	// are stream sequences.
+	userEventsStream  = "USERS"
+	userEventsSubject = "users.>"
+	userEventsMaxAge  = 7 * 24 * time.Hour
+)
+
+type userEventsRepo struct {
+	js     jetstream.JetStream
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+// NewUserEventsRepo creates the USERS stream if needed. Without JetStream the
+// changes of users are dropped and WatchUsers is unavailable.
+func NewUserEventsRepo(data Data, logger log.Logger, tracer trace.Tracer) biz.UserEventsRepo {
+	r := &userEventsRepo{log: log.NewHelper(logger), tracer: tracer}
+	if js := data.GetJetStream(); js != nil && *js != nil {
+		r.js = *js
+	}
+	if r.js == nil {
+		r.log.Warn("No JetStream found, changes of users are not published")
+		return r
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     userEventsStream,
+		Subjects: []string{userEventsSubject},
+		MaxAge:   userEventsMaxAge,
+		Storage:  jetstream.FileStorage,
+	})
+	if err != nil {
+		r.log.Errorf("failed to ensure the %s stream: %s", userEventsStream, err)
+	}
+	return r
+}
+
+// startSpan starts a span of a USERS stream operation.
+func (r *userEventsRepo) startSpan(ctx context.Context, name, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return monitor.StartSpan(ctx, r.tracer, name, append([]attribute.KeyValue{
+		semconv.MessagingSystemKey.String("nats"),
+		semconv.MessagingOperationKey.String(op),
+	}, attrs...)...)
+}
+
+func (r *userEventsRepo) Publish(ctx context.Context, change *biz.Change[*biz.User]) (err error) {
+	if r.js == nil {
+		return nil
+	}
+	subject := "users." + string(change.Type)
+	ctx, span := r.startSpan(ctx, "userEventsRepo.Publish", "publish", semconv.MessagingDestinationName(subject), biz.AttrUserID.String(change.ID))
+	defer func() { monitor.EndSpan(span, err) }()
+	raw, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	_, err = r.js.Publish(ctx, subject, raw)
+	return err
+}
+
+func (r *userEventsRepo) Watch(ctx context.Context, resumeToken string, fn func(*biz.Change[*biz.User]) error) (err error) {
+	if r.js == nil {
+		return usersV1.ErrorUserUnavailable("watching users needs NATS JetStream")
+	}
+	ctx, span := r.startSpan(ctx, "userEventsRepo.Watch", "receive", semconv.MessagingDestinationName(userEventsSubject))
+	defer func() { monitor.EndSpan(span, err) }()
+	cfg := jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{userEventsSubject},
+		DeliverPolicy:  jetstream.DeliverNewPolicy,
+	}
+	if resumeToken != "" {
+		seq, err := r.resumeSequence(ctx, resumeToken)
+		if err != nil {
+			return err
+		}
+		cfg.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		cfg.OptStartSeq = seq + 1
+	}
+	cons, err := r.js.OrderedConsumer(ctx, userEventsStream, cfg)
+	if err != nil {
+		r.log.Errorf("failed to consume the %s stream: %s", userEventsStream, err)
+		return usersV1.ErrorUserInternal("user events failure").WithCause(err)
+	}
+	msgs, err := cons.Messages()
+	if err != nil {
+		return usersV1.ErrorUserInternal("user events failure").WithCause(err)
+	}
+	defer msgs.Stop()
+	stop := context.AfterFunc(ctx, msgs.Stop)
+	defer stop()
+	for {
+		msg, err := msgs.Next()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			r.log.Errorf("failed to read the %s stream: %s", userEventsStream, err)
+			return usersV1.ErrorUserInternal("user events failure").WithCause(err)
+		}
+		meta, err := msg.Metadata()
+		if err != nil {
+			return usersV1.ErrorUserInternal("user events failure").WithCause(err)
+		}
+		var change biz.Change[*biz.User]
+		if err := json.Unmarshal(msg.Data(), &change); err != nil {
+			r.log.Warnf("skipping malformed user event %d: %s", meta.Sequence.Stream, err)
+			continue
+		}
+		change.ResumeToken = strconv.FormatUint(meta.Sequence.Stream, 10)
+		if err := fn(&change); err != nil {
+			return err
+		}
+	}
+}
+
+// resumeSequence parses a resume token, failing when the stream no longer
+// holds the changes following it.
+func (r *userEventsRepo) resumeSequence(ctx context.Context, token string) (uint64, error) {
+	seq, err := strconv.ParseUint(token, 10, 64)
+	if err != nil || seq == 0 {
+		return 0, usersV1.ErrorUserInvalidArgument("invalid resume token %q", token)
+	}
+	stream, err := r.js.Stream(ctx, userEventsStream)
+	if err != nil {
+		r.log.Errorf("failed to read the %s stream: %s", userEventsStream, err)
+		return 0, usersV1.ErrorUserInternal("user events failure").WithCause(err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		r.log.Errorf("failed to read the %s stream: %s", userEventsStream, err)
+		return 0, usersV1.ErrorUserInternal("user events failure").WithCause(err)
+	}
+	if info.State.FirstSeq > seq+1 {
+		return 0, usersV1.ErrorUserInvalidArgument("resume token %s expired, list the users again", token)
+	}
+	return seq, nil
+}
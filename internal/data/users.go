@@ -2,17 +2,22 @@ package data
 
 import (
 	"context"
-	"fmt"
+	"strings"
+	"unicode"
 
+	usersV1 "layout/api/users/v1"
 	"layout/internal/biz"
+	"layout/pkg/monitor"
+	"layout/pkg/redact"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Users struct {
@@ -25,12 +30,12 @@ type Users struct {
 }
 
 type usersRepo struct {
-	db  *gorm.DB
-	log *log.Helper
-	tp  trace.Tracer
+	db     *gorm.DB
+	log    *log.Helper
+	tracer trace.Tracer
 }
 
-func NewUsersRepo(data Data, logger log.Logger, tp trace.Tracer) (biz.UsersRepo, error) {
+func NewUsersRepo(data Data, logger log.Logger, tracer trace.Tracer) (biz.UsersRepo, error) {
 	lg := log.NewHelper(logger)
 
 	g := data.GetGormDB()
@@ -40,19 +45,24 @@ func NewUsersRepo(data Data, logger log.Logger, tp trace.Tracer) (biz.UsersRepo,
 	}
 
 	return &usersRepo{
-		db:  g,
-		log: lg,
-		tp:  tp,
+		db:     g,
+		log:    lg,
+		tracer: tracer,
 	}, nil
 }
 
-func (r usersRepo) Save(ctx context.Context, u *biz.User) (string, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "usersRepo.Save")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "user",
-		Value: attribute.StringValue(u.Username + " " + u.Email + " " + u.Phone),
-	})
+// startSpan starts a span of a users table operation, op being the SQL verb.
+func (r usersRepo) startSpan(ctx context.Context, name, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return monitor.StartSpan(ctx, r.tracer, name, append([]attribute.KeyValue{
+		semconv.DBSystemPostgreSQL,
+		semconv.DBSQLTable("users"),
+		semconv.DBOperation(op),
+	}, attrs...)...)
+}
+
+func (r usersRepo) Save(ctx context.Context, u *biz.User) (_ string, err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.Save", "INSERT", u.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 	user := Users{
 		Username: u.Username,
 		Email:    u.Email,
@@ -62,26 +72,21 @@ func (r usersRepo) Save(ctx context.Context, u *biz.User) (string, error) {
 	res := r.db.WithContext(ctx).Save(&user)
 	if res.Error != nil {
 		r.log.Error("failed to save user", res.Error)
-		return "", res.Error
+		return "", userError(res.Error, "")
 	}
 	if res.RowsAffected == 0 {
-		r.log.Error("failed to save user", "err was empty but insertions failed")
-		return "", errors.InternalServer("failed to save user", "err was empty but insertions failed")
+		r.log.Error("failed to save user: no row inserted")
+		return "", usersV1.ErrorUserInternal("user was not saved")
 	}
 	return user.ID.String(), nil
 }
 
-func (r usersRepo) GetByID(ctx context.Context, id string) (*biz.User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "usersRepo.GetByID")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (r usersRepo) GetByID(ctx context.Context, id string) (_ *biz.User, err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.GetByID", "SELECT", biz.AttrUserID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 	uid, err := uuid.Parse(id)
 	if err != nil {
-		r.log.Error("failed to parse user id", err)
-		return nil, err
+		return nil, usersV1.ErrorUserInvalidArgument("invalid user id %q", id)
 	}
 	user := &Users{
 		ID: uid,
@@ -89,12 +94,10 @@ func (r usersRepo) GetByID(ctx context.Context, id string) (*biz.User, error) {
 
 	res := r.db.WithContext(ctx).First(&user)
 	if res.Error != nil {
-		r.log.Error("failed to get user", res.Error)
-		return nil, res.Error
-	}
-	if res.RowsAffected == 0 {
-		r.log.Error("failed to get user", "err was empty but insertions failed")
-		return nil, errors.InternalServer("failed to get user", "err was empty but insertions failed")
+		if !errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			r.log.Error("failed to get user", res.Error)
+		}
+		return nil, userError(res.Error, id)
 	}
 	return &biz.User{
 		ID:       user.ID.String(),
@@ -105,13 +108,9 @@ func (r usersRepo) GetByID(ctx context.Context, id string) (*biz.User, error) {
 	}, nil
 }
 
-func (r usersRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*biz.User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "usersRepo.List")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", pagination.Page, pagination.Size)),
-	})
+func (r usersRepo) List(ctx context.Context, pagination *biz.Pagination) (_ []*biz.User, err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.List", "SELECT", pagination.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 	offset := pagination.Page * pagination.Size
 	take := pagination.Size
 	if offset < 0 {
@@ -126,12 +125,10 @@ func (r usersRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*biz
 
 	if res.Error != nil {
 		r.log.Error("failed to list users", res.Error)
-		return nil, res.Error
+		return nil, userError(res.Error, "")
 	}
 	if len(users) == 0 {
-		err := errors.NotFound("users", "no users found")
-		r.log.Error("failed to list users", err)
-		return nil, err
+		return nil, usersV1.ErrorUserNotFound("no users found")
 	}
 
 	var usersRes []*biz.User
@@ -147,33 +144,28 @@ func (r usersRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*biz
 	return usersRes, nil
 }
 
-func (r usersRepo) Update(ctx context.Context, u *biz.User) (*biz.User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "usersRepo.Update")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "user",
-		Value: attribute.StringValue(u.Username + " " + u.Email + " " + u.Phone),
-	})
+func (r usersRepo) Update(ctx context.Context, u *biz.User) (_ *biz.User, err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.Update", "UPDATE", u.SpanAttributes()...)
+	defer func() { monitor.EndSpan(span, err) }()
 	uid, err := uuid.Parse(u.ID)
 	if err != nil {
-		r.log.Error("failed to parse user id", err)
-		return nil, err
+		return nil, usersV1.ErrorUserInvalidArgument("invalid user id %q", u.ID)
 	}
-	user := Users{
-		ID:       uid,
+	// a struct only updates its non-zero fields, leaving out the ones the
+	// request did not set; the updated row is read back by RETURNING
+	var user Users
+	res := r.db.WithContext(ctx).Model(&user).Clauses(clause.Returning{}).Where("id = ?", uid).Updates(Users{
 		Username: u.Username,
 		Email:    u.Email,
 		Phone:    u.Phone,
 		Picture:  u.Picture,
-	}
-	res := r.db.WithContext(ctx).Save(&user)
+	})
 	if res.Error != nil {
 		r.log.Error("failed to update user", res.Error)
-		return nil, res.Error
+		return nil, userError(res.Error, u.ID)
 	}
 	if res.RowsAffected == 0 {
-		r.log.Error("failed to update user", "err was empty but insertions failed")
-		return nil, errors.InternalServer("failed to update user", "err was empty but insertions failed")
+		return nil, usersV1.ErrorUserNotFound("user %s not found", u.ID)
 	}
 	return &biz.User{
 		ID:       user.ID.String(),
@@ -184,17 +176,12 @@ func (r usersRepo) Update(ctx context.Context, u *biz.User) (*biz.User, error) {
 	}, nil
 }
 
-func (r usersRepo) Delete(ctx context.Context, id string) (*biz.User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "usersRepo.Delete")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(id),
-	})
+func (r usersRepo) Delete(ctx context.Context, id string) (_ *biz.User, err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.Delete", "DELETE", biz.AttrUserID.String(id))
+	defer func() { monitor.EndSpan(span, err) }()
 	uid, err := uuid.Parse(id)
 	if err != nil {
-		r.log.Error("failed to parse user id", err)
-		return nil, err
+		return nil, usersV1.ErrorUserInvalidArgument("invalid user id %q", id)
 	}
 	user := Users{
 		ID: uid,
@@ -202,11 +189,10 @@ func (r usersRepo) Delete(ctx context.Context, id string) (*biz.User, error) {
 	res := r.db.WithContext(ctx).Delete(&user)
 	if res.Error != nil {
 		r.log.Error("failed to delete user", res.Error)
-		return nil, res.Error
+		return nil, userError(res.Error, id)
 	}
 	if res.RowsAffected == 0 {
-		r.log.Error("failed to delete user", "err was empty but insertions failed")
-		return nil, errors.InternalServer("failed to delete user", "err was empty but insertions failed")
+		return nil, usersV1.ErrorUserNotFound("user %s not found", id)
 	}
 	return &biz.User{
 		ID:       user.ID.String(),
@@ -217,29 +203,86 @@ func (r usersRepo) Delete(ctx context.Context, id string) (*biz.User, error) {
 	}, nil
 }
 
-func (r usersRepo) Search(ctx context.Context, keyword string, pagination *biz.Pagination) ([]*biz.User, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "usersRepo.Search")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "keyword",
-		Value: attribute.StringValue(keyword),
-	})
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", pagination.Page, pagination.Size)),
+// userSearchWhere matches the users of a search: the words of the query
+// prefix the words of the search column, or the whole query is similar to
+// (pg_trgm) or part of the username, email or phone.
+const userSearchWhere = `
+WHERE deleted_at IS NULL AND (
+	search @@ to_tsquery('simple', @words)
+	OR username % @query OR email % @query OR phone % @query
+	OR username ILIKE @pattern OR email ILIKE @pattern OR phone ILIKE @pattern
+)`
+
+// userSearchSQL ranks the users matching a search by text rank plus best
+// similarity, usernames breaking ties, and counts all of them along.
+const userSearchSQL = `
+SELECT users.*, count(*) OVER () AS total FROM users` + userSearchWhere + `
+ORDER BY ts_rank(search, to_tsquery('simple', @words))
+	+ greatest(similarity(username, @query), similarity(email, @query), similarity(phone, @query)) DESC,
+	username
+OFFSET @offset LIMIT @limit`
+
+// userSearchCountSQL counts the users matching a search, for pages past
+// the last one.
+const userSearchCountSQL = `SELECT count(*) FROM users` + userSearchWhere
+
+// userSearchWords turns the words of a search into a prefix tsquery, e.g.
+// "John Do" into "john:* & do:*". Punctuation is dropped so that any input
+// makes a valid query.
+func userSearchWords(keyword string) string {
+	words := strings.FieldsFunc(strings.ToLower(keyword), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
 	})
-	var users []Users
-	res := r.db.WithContext(ctx).Where("username LIKE ?", "%"+keyword+"%").Find(&users)
+	for i, w := range words {
+		words[i] = w + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// likeEscaper escapes the wildcards of ILIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// userSearchArgs returns the named arguments of userSearchSQL, a size of 0
+// leaving the page unbounded.
+func userSearchArgs(keyword string, offset, size int32) map[string]interface{} {
+	var limit interface{}
+	if size > 0 {
+		limit = size
+	}
+	return map[string]interface{}{
+		"words":   userSearchWords(keyword),
+		"query":   keyword,
+		"pattern": "%" + likeEscaper.Replace(keyword) + "%",
+		"offset":  offset,
+		"limit":   limit,
+	}
+}
+
+func (r usersRepo) Search(ctx context.Context, keyword string, pagination *biz.Pagination) (_ []*biz.User, _ int64, err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.Search", "SELECT", append(pagination.SpanAttributes(), redact.Attr(string(biz.AttrSearchQuery), keyword))...)
+	defer func() { monitor.EndSpan(span, err) }()
+	offset := max(0, pagination.Page*pagination.Size)
+	args := userSearchArgs(keyword, offset, pagination.Size)
+	var rows []struct {
+		Users
+		Total int64
+	}
+	res := r.db.WithContext(ctx).Raw(userSearchSQL, args).Scan(&rows)
 	if res.Error != nil {
 		r.log.Error("failed to search users", res.Error)
-		return nil, res.Error
+		return nil, 0, userError(res.Error, "")
 	}
-	if res.RowsAffected == 0 {
-		r.log.Error("failed to search users", "err was empty but insertions failed")
-		return nil, errors.InternalServer("failed to search users", "err was empty but insertions failed")
+	var total int64
+	if len(rows) > 0 {
+		total = rows[0].Total
+	} else if offset > 0 {
+		if err := r.db.WithContext(ctx).Raw(userSearchCountSQL, args).Scan(&total).Error; err != nil {
+			r.log.Error("failed to count users", err)
+			return nil, 0, userError(err, "")
+		}
 	}
-	var usersRes []*biz.User
-	for _, user := range users {
+	usersRes := make([]*biz.User, 0, len(rows))
+	for _, user := range rows {
 		usersRes = append(usersRes, &biz.User{
 			ID:       user.ID.String(),
 			Username: user.Username,
@@ -248,5 +291,70 @@ func (r usersRepo) Search(ctx context.Context, keyword string, pagination *biz.P
 			Picture:  user.Picture,
 		})
 	}
-	return usersRes, nil
+	return usersRes, total, nil
+}
+
+func (r usersRepo) Exists(ctx context.Context, field biz.UniqueField, value string) (_ bool, err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.Exists", "SELECT", biz.AttrUniqueField.String(string(field)))
+	defer func() { monitor.EndSpan(span, err) }()
+	switch field {
+	case biz.FieldUsername, biz.FieldEmail, biz.FieldPhone:
+	default:
+		return false, usersV1.ErrorUserInvalidArgument("%s is not a unique user field", field)
+	}
+	// soft-deleted users keep their row, so they still hold the unique index
+	var n int64
+	res := r.db.WithContext(ctx).Unscoped().Model(&Users{}).Where(string(field)+" = ?", value).Limit(1).Count(&n)
+	if res.Error != nil {
+		r.log.Error("failed to check user availability", res.Error)
+		return false, userError(res.Error, "")
+	}
+	return n > 0, nil
+}
+
+func (r usersRepo) GetByIDs(ctx context.Context, ids []string) (_ []biz.BatchItem[*biz.User], err error) {
+	ctx, span := r.startSpan(ctx, "usersRepo.GetByIDs", "SELECT", biz.AttrBatchSize.Int(len(ids)))
+	defer func() { monitor.EndSpan(span, err) }()
+	items := make([]biz.BatchItem[*biz.User], len(ids))
+	keys := make([]string, len(ids))
+	uids := make([]uuid.UUID, 0, len(ids))
+	for i, id := range ids {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			items[i].Err = usersV1.ErrorUserInvalidArgument("invalid user id %q", id)
+			continue
+		}
+		keys[i] = uid.String()
+		uids = append(uids, uid)
+	}
+
+	var users []Users
+	if len(uids) > 0 {
+		res := r.db.WithContext(ctx).Where("id IN ?", uids).Find(&users)
+		if res.Error != nil {
+			r.log.Error("failed to get users", res.Error)
+			return nil, userError(res.Error, "")
+		}
+	}
+	found := make(map[string]*biz.User, len(users))
+	for _, user := range users {
+		found[user.ID.String()] = &biz.User{
+			ID:       user.ID.String(),
+			Username: user.Username,
+			Email:    user.Email,
+			Phone:    user.Phone,
+			Picture:  user.Picture,
+		}
+	}
+	for i, id := range ids {
+		if items[i].Err != nil {
+			continue
+		}
+		if u, ok := found[keys[i]]; ok {
+			items[i].Value = u
+		} else {
+			items[i].Err = usersV1.ErrorUserNotFound("user %s not found", id)
+		}
+	}
+	return items, nil
 }
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUserSearchWords(t *testing.T) {
+	tests := []struct {
+		keyword string
+		want    string
+	}{
+		{"john", "john:*"},
+		{"John Do", "john:* & do:*"},
+		{"  ada   lovelace ", "ada:* & lovelace:*"},
+		{"ada@example.com", "ada:* & example:* & com:*"},
+		{"+33 6-12", "33:* & 6:* & 12:*"},
+		{"o'brien & (x|y):*", "o:* & brien:* & x:* & y:*"},
+		{"Élodie", "élodie:*"},
+		{"!?", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := userSearchWords(tt.keyword); got != tt.want {
+			t.Errorf("userSearchWords(%q) = %q, want %q", tt.keyword, got, tt.want)
+		}
+	}
+}
+
+func TestUserSearchArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		keyword      string
+		offset, size int32
+		want         map[string]interface{}
+	}{
+		{
+			name:    "first page",
+			keyword: "John Do",
+			size:    20,
+			want: map[string]interface{}{
+				"words":   "john:* & do:*",
+				"query":   "John Do",
+				"pattern": "%John Do%",
+				"offset":  int32(0),
+				"limit":   int32(20),
+			},
+		},
+		{
+			name:    "wildcards escaped",
+			keyword: `50%_off\`,
+			offset:  40,
+			size:    20,
+			want: map[string]interface{}{
+				"words":   "50:* & off:*",
+				"query":   `50%_off\`,
+				"pattern": `%50\%\_off\\%`,
+				"offset":  int32(40),
+				"limit":   int32(20),
+			},
+		},
+		{
+			name:    "unbounded page",
+			keyword: "ada",
+			want: map[string]interface{}{
+				"words":   "ada:*",
+				"query":   "ada",
+				"pattern": "%ada%",
+				"offset":  int32(0),
+				"limit":   nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userSearchArgs(tt.keyword, tt.offset, tt.size); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("userSearchArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"crypto/subtle"
+	nethttp "net/http"
+	"strings"
+)
+
+// requireToken lets through requests carrying "Authorization: Bearer <token>".
+func requireToken(token string, next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			nethttp.Error(w, "unauthorized", nethttp.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"mime"
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+
+	productsV1 "layout/api/products/v1"
+	"layout/internal/service"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+var (
+	fileFormats = map[string]productsV1.FileFormat{
+		"csv":                  productsV1.FileFormat_FILE_FORMAT_CSV,
+		"text/csv":             productsV1.FileFormat_FILE_FORMAT_CSV,
+		"ndjson":               productsV1.FileFormat_FILE_FORMAT_NDJSON,
+		"application/x-ndjson": productsV1.FileFormat_FILE_FORMAT_NDJSON,
+		"application/ndjson":   productsV1.FileFormat_FILE_FORMAT_NDJSON,
+	}
+	fileTypes = map[productsV1.FileFormat]string{
+		productsV1.FileFormat_FILE_FORMAT_CSV:    "text/csv",
+		productsV1.FileFormat_FILE_FORMAT_NDJSON: "application/x-ndjson",
+	}
+)
+
+// fileFormat picks the format named by the format query parameter, falling
+// back to the media type of the body.
+func fileFormat(name, contentType string) productsV1.FileFormat {
+	if name == "" {
+		name, _, _ = mime.ParseMediaType(contentType)
+	}
+	return fileFormats[strings.ToLower(name)]
+}
+
+// registerCatalogue routes the product import and export, which the generated
+// bindings cannot express: the upload is the raw request body and the export
+// a download written as the products are read. Both run through the server
+// middleware but not its timeout, which is meant for single requests.
+func registerCatalogue(srv *http.Server, products *service.ProductsService) {
+	r := srv.Route("/")
+	r.POST("/products:import", func(ctx http.Context) error {
+		dryRun, _ := strconv.ParseBool(ctx.Query().Get("dry_run"))
+		opts := &productsV1.ImportOptions{
+			Format: fileFormat(ctx.Query().Get("format"), ctx.Header().Get("Content-Type")),
+			DryRun: dryRun,
+		}
+		http.SetOperation(ctx, productsV1.Products_ImportProducts_FullMethodName)
+		h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+			return products.ImportFile(c, req.(*productsV1.ImportOptions), ctx.Request().Body)
+		})
+		out, err := h(context.WithoutCancel(ctx), opts)
+		if err != nil {
+			return err
+		}
+		ctx.Response().Header().Set("Location", "/products/imports/"+out.(*productsV1.ImportJob).GetId())
+		return ctx.Result(stdhttp.StatusAccepted, out)
+	})
+	r.GET("/products:export", func(ctx http.Context) error {
+		var in productsV1.ExportProductsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		format := productsV1.FileFormat_FILE_FORMAT_CSV
+		if name := ctx.Query().Get("format"); name != "" {
+			format = fileFormat(name, "")
+		}
+		w := &download{w: ctx.Response(), format: format}
+		http.SetOperation(ctx, productsV1.Products_ExportProducts_FullMethodName)
+		h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+			return nil, products.ExportFile(c, req.(*productsV1.ExportProductsRequest), format, w)
+		})
+		if _, err := h(context.WithoutCancel(ctx), &in); err != nil {
+			if w.started {
+				// the status is sent, drop the connection so that the
+				// client sees a truncated download rather than a short one
+				panic(stdhttp.ErrAbortHandler)
+			}
+			return err
+		}
+		w.start()
+		return nil
+	})
+}
+
+// download sends the headers of an exported file with its first bytes, so
+// that an export failing early is still answered with an error.
+type download struct {
+	w       stdhttp.ResponseWriter
+	format  productsV1.FileFormat
+	started bool
+}
+
+func (d *download) start() {
+	if d.started {
+		return
+	}
+	d.started = true
+	ext := strings.TrimPrefix(strings.ToLower(d.format.String()), "file_format_")
+	d.w.Header().Set("Content-Type", fileTypes[d.format])
+	d.w.Header().Set("Content-Disposition", `attachment; filename="products.`+ext+`"`)
+	d.w.WriteHeader(stdhttp.StatusOK)
+}
+
+func (d *download) Write(p []byte) (int, error) {
+	d.start()
+	return d.w.Write(p)
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	stdhttp "net/http"
+	"strings"
+	"time"
+
+	productsV1 "layout/api/products/v1"
+	usersV1 "layout/api/users/v1"
+	"layout/internal/service"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// sseHeartbeat is the period of the comments keeping idle event streams
+// open through proxies.
+const sseHeartbeat = 15 * time.Second
+
+// event is a server-sent event, id being the resume token of its change.
+type event struct {
+	id   string
+	name string
+	data interface{}
+}
+
+// registerEvents routes the server-sent event streams of the Watch RPCs.
+// Clients resume with the Last-Event-ID header, which EventSource sends on
+// reconnection, or the resume_token query parameter.
+func registerEvents(srv *http.Server, users *service.UsersService, products *service.ProductsService) {
+	r := srv.Route("/")
+	r.GET("/users:watch", func(ctx http.Context) error {
+		req := &usersV1.WatchUsersRequest{ResumeToken: resumeToken(ctx)}
+		return serveEvents(ctx, usersV1.Users_WatchUsers_FullMethodName, req, func(c context.Context, send func(event) error) error {
+			return users.WatchUserChanges(c, req, func(ch *usersV1.UserChange) error {
+				return send(event{id: ch.GetResumeToken(), name: strings.ToLower(ch.GetType().String()), data: ch})
+			})
+		})
+	})
+	r.GET("/products:watch", func(ctx http.Context) error {
+		req := &productsV1.WatchProductsRequest{ResumeToken: resumeToken(ctx)}
+		return serveEvents(ctx, productsV1.Products_WatchProducts_FullMethodName, req, func(c context.Context, send func(event) error) error {
+			return products.WatchProductChanges(c, req, func(ch *productsV1.ProductChange) error {
+				return send(event{id: ch.GetResumeToken(), name: strings.ToLower(ch.GetType().String()), data: ch})
+			})
+		})
+	})
+}
+
+// clientGoneKey holds the Done channel of a request context before the
+// server timeout wraps it, closed when the client disconnects.
+type clientGoneKey struct{}
+
+// keepClientGone is an http filter keeping the Done channel of requests for
+// clientGone, since the filters run before the server timeout.
+func keepClientGone(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientGoneKey{}, r.Context().Done())))
+	})
+}
+
+// clientGone returns a channel closed when the client of ctx disconnects.
+func clientGone(ctx context.Context) <-chan struct{} {
+	if gone, ok := ctx.Value(clientGoneKey{}).(<-chan struct{}); ok {
+		return gone
+	}
+	return ctx.Done()
+}
+
+// resumeToken returns the token a watch resumes from, the Last-Event-ID
+// header taking precedence over the resume_token query parameter.
+func resumeToken(ctx http.Context) string {
+	if id := ctx.Header().Get("Last-Event-ID"); id != "" {
+		return id
+	}
+	return ctx.Query().Get("resume_token")
+}
+
+// serveEvents runs watch through the server middleware and writes the events
+// it sends as text/event-stream. The response starts with the first event or
+// heartbeat, so a watch failing right away, e.g. on an expired resume token,
+// is still answered with a problem; later failures end the stream and the
+// client resumes from its last event.
+func serveEvents(ctx http.Context, operation string, req interface{}, watch func(ctx context.Context, send func(event) error) error) error {
+	http.SetOperation(ctx, operation)
+	// the watch outlives the server timeout and ends with the client
+	gone := clientGone(ctx)
+	watchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+	events := make(chan event)
+	done := make(chan error, 1)
+	h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+		return nil, watch(c, func(e event) error {
+			select {
+			case events <- e:
+				return nil
+			case <-c.Done():
+				return c.Err()
+			}
+		})
+	})
+	go func() {
+		_, err := h(watchCtx, req)
+		done <- err
+	}()
+
+	w := ctx.Response()
+	rc := stdhttp.NewResponseController(w)
+	started := false
+	write := func(format string, args ...interface{}) error {
+		if !started {
+			started = true
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(stdhttp.StatusOK)
+		}
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case err = <-done:
+			if !started {
+				return err
+			}
+			return nil
+		case e := <-events:
+			var data []byte
+			if data, err = encoding.GetCodec(json.Name).Marshal(e.data); err == nil {
+				err = write("id: %s\nevent: %s\ndata: %s\n\n", e.id, e.name, data)
+			}
+		case <-heartbeat.C:
+			err = write(": heartbeat\n\n")
+		case <-gone:
+			err = context.Canceled
+		}
+		if err != nil {
+			cancel()
+			<-done
+			return nil
+		}
+	}
+}
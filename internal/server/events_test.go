@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	usersV1 "layout/api/users/v1"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+func TestResumeToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		header string
+		want   string
+	}{
+		{"none", "/watch", "", ""},
+		{"query", "/watch?resume_token=q1", "", "q1"},
+		{"header", "/watch", "h1", "h1"},
+		{"header over query", "/watch?resume_token=q1", "h1", "h1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			srv := http.NewServer()
+			srv.Route("/").GET("/watch", func(ctx http.Context) error {
+				got = resumeToken(ctx)
+				return nil
+			})
+			req := httptest.NewRequest("GET", tt.url, nil)
+			if tt.header != "" {
+				req.Header.Set("Last-Event-ID", tt.header)
+			}
+			srv.ServeHTTP(httptest.NewRecorder(), req)
+			if got != tt.want {
+				t.Errorf("resumeToken() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServeEvents(t *testing.T) {
+	tests := []struct {
+		name       string
+		watch      func(ctx context.Context, send func(event) error) error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "events",
+			watch: func(_ context.Context, send func(event) error) error {
+				for _, id := range []string{"t1", "t2"} {
+					if err := send(event{id: id, name: "created", data: map[string]string{"id": id}}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantStatus: 200,
+			wantBody: "id: t1\nevent: created\ndata: {\"id\":\"t1\"}\n\n" +
+				"id: t2\nevent: created\ndata: {\"id\":\"t2\"}\n\n",
+		},
+		{
+			name: "failure before the first event",
+			watch: func(context.Context, func(event) error) error {
+				return usersV1.ErrorUserInvalidArgument("resume token expired")
+			},
+			wantStatus: 400,
+		},
+		{
+			name: "failure after the first event",
+			watch: func(_ context.Context, send func(event) error) error {
+				if err := send(event{id: "t1", name: "deleted", data: map[string]string{"id": "t1"}}); err != nil {
+					return err
+				}
+				return usersV1.ErrorUserInternal("change stream closed")
+			},
+			wantStatus: 200,
+			wantBody:   "id: t1\nevent: deleted\ndata: {\"id\":\"t1\"}\n\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := http.NewServer(http.ErrorEncoder(encodeError))
+			srv.Route("/").GET("/users:watch", func(ctx http.Context) error {
+				return serveEvents(ctx, usersV1.Users_WatchUsers_FullMethodName, &usersV1.WatchUsersRequest{}, tt.watch)
+			})
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, httptest.NewRequest("GET", "/users:watch", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != 200 {
+				if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+					t.Errorf("Content-Type = %q, want application/problem+json", ct)
+				}
+				return
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+				t.Errorf("Content-Type = %q, want text/event-stream", ct)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
@@ -5,13 +5,15 @@ import (
 	usersV1 "layout/api/users/v1"
 	"layout/internal/conf"
 	"layout/internal/service"
+	"layout/pkg/monitor"
+	"layout/pkg/redact"
+	"layout/pkg/validation"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 
 	"go.opentelemetry.io/otel/metric"
@@ -30,23 +32,26 @@ func NewGRPCServer(
 	if err != nil {
 		return nil, err
 	}
-	seconds, err := metrics.DefaultSecondsHistogram(meter, metrics.DefaultServerSecondsHistogramName)
+	seconds, err := metrics.DefaultSecondsHistogram(meter, monitor.ServerSecondsHistogramName)
 	if err != nil {
 		return nil, err
 	}
-	opts := []grpc.ServerOption{
-		grpc.Middleware(
-			recovery.Recovery(),
-			tracing.Server(
-				tracing.WithTracerProvider(tp),
-			),
-			logging.Server(logger),
-			metrics.Server(
-				metrics.WithRequests(counter),
-				metrics.WithSeconds(seconds),
-			),
-			validate.Validator(),
+	ms := []middleware.Middleware{
+		recovery.Recovery(),
+		tracing.Server(
+			tracing.WithTracerProvider(tp),
+		),
+		redact.Logging(logger),
+		redact.Server(),
+		metrics.Server(
+			metrics.WithRequests(counter),
+			metrics.WithSeconds(seconds),
 		),
+		validation.Validator(),
+	}
+	opts := []grpc.ServerOption{
+		grpc.Middleware(ms...),
+		grpc.StreamInterceptor(streamMiddleware(ms...)),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
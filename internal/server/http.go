@@ -1,19 +1,24 @@
 package server
 
 import (
+	nethttp "net/http"
+	"sync/atomic"
+
 	productsV1 "layout/api/products/v1"
 	usersV1 "layout/api/users/v1"
 	"layout/internal/conf"
 	"layout/internal/service"
+	"layout/pkg/config"
+	"layout/pkg/monitor"
+	"layout/pkg/redact"
+	"layout/pkg/validation"
 
 	"github.com/gorilla/handlers"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -23,6 +28,7 @@ import (
 
 func NewHTTPServer(
 	c *conf.Server,
+	w *config.Watcher,
 	users *service.UsersService,
 	products *service.ProductsService,
 	logger log.Logger,
@@ -33,7 +39,7 @@ func NewHTTPServer(
 	if err != nil {
 		return nil, err
 	}
-	seconds, err := metrics.DefaultSecondsHistogram(meter, metrics.DefaultServerSecondsHistogramName)
+	seconds, err := metrics.DefaultSecondsHistogram(meter, monitor.ServerSecondsHistogramName)
 	if err != nil {
 		return nil, err
 	}
@@ -43,23 +49,40 @@ func NewHTTPServer(
 			tracing.Server(
 				tracing.WithTracerProvider(tp),
 			),
-			logging.Server(logger),
+			redact.Logging(logger),
+			redact.Server(),
 			metrics.Server(
 				metrics.WithRequests(counter),
 				metrics.WithSeconds(seconds),
 			),
-			validate.Validator(),
+			validation.Validator(),
 		),
+		http.ErrorEncoder(encodeError),
+		http.Filter(keepClientGone),
 	}
 	if c.Http.GetCors().GetEnabled() {
 		allowHeaders := c.Http.GetCors().GetAllowHeaders()
 		allowMethods := c.Http.GetCors().GetAllowMethods()
-		allowOrigins := c.Http.GetCors().GetAllowOrigins()
-		cors := handlers.CORS(
-			handlers.AllowedHeaders(allowHeaders),
-			handlers.AllowedMethods(allowMethods),
-			handlers.AllowedOrigins(allowOrigins),
-		)
+		newCORS := func(origins []string) http.FilterFunc {
+			return handlers.CORS(
+				handlers.AllowedHeaders(allowHeaders),
+				handlers.AllowedMethods(allowMethods),
+				handlers.AllowedOrigins(origins),
+			)
+		}
+		// origins are reloaded live, see config.Watcher
+		var current atomic.Pointer[http.FilterFunc]
+		initial := newCORS(c.Http.GetCors().GetAllowOrigins())
+		current.Store(&initial)
+		w.OnCORSOrigins(func(origins []string) {
+			next := newCORS(origins)
+			current.Store(&next)
+		})
+		cors := func(next nethttp.Handler) nethttp.Handler {
+			return nethttp.HandlerFunc(func(rw nethttp.ResponseWriter, r *nethttp.Request) {
+				(*current.Load())(next).ServeHTTP(rw, r)
+			})
+		}
 		opts = append(opts, http.Filter(cors))
 	}
 	if c.Http.Network != "" {
@@ -78,8 +101,13 @@ func NewHTTPServer(
 			EnableOpenMetrics: true,
 		},
 	))
+	if token := c.GetAdmin().GetToken(); token != "" {
+		srv.Handle("/debug/loglevel", requireToken(token, monitor.LevelHandler()))
+	}
 
 	usersV1.RegisterUsersHTTPServer(srv, users)
 	productsV1.RegisterProductsHTTPServer(srv, products)
+	registerCatalogue(srv, products)
+	registerEvents(srv, users, products)
 	return srv, nil
 }
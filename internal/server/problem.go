@@ -0,0 +1,54 @@
+package server
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"layout/pkg/validation"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// problem is an RFC 9457 problem details body. Reason and metadata of the
+// kratos error are kept as extension members, violations list every failed
+// rule of an invalid request.
+type problem struct {
+	Type       string                 `json:"type"`
+	Title      string                 `json:"title"`
+	Status     int                    `json:"status"`
+	Detail     string                 `json:"detail,omitempty"`
+	Instance   string                 `json:"instance,omitempty"`
+	Reason     string                 `json:"reason,omitempty"`
+	Metadata   map[string]string      `json:"metadata,omitempty"`
+	Violations []validation.Violation `json:"violations,omitempty"`
+}
+
+// encodeError renders every error returned by a handler as
+// application/problem+json, whatever the codec negotiated for replies.
+func encodeError(w stdhttp.ResponseWriter, r *stdhttp.Request, err error) {
+	se := errors.FromError(err)
+	p := problem{
+		Type:     "about:blank",
+		Title:    stdhttp.StatusText(int(se.Code)),
+		Status:   int(se.Code),
+		Detail:   se.Message,
+		Instance: r.URL.Path,
+		Reason:   se.Reason,
+		Metadata: se.Metadata,
+	}
+	if p.Title == "" {
+		p.Title = se.Reason
+	}
+	var ve *validation.Error
+	if errors.As(err, &ve) {
+		p.Violations = ve.Violations
+	}
+	body, err := json.Marshal(p)
+	if err != nil {
+		w.WriteHeader(stdhttp.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	_, _ = w.Write(body)
+}
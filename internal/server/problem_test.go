@@ -0,0 +1,82 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	usersV1 "layout/api/users/v1"
+	"layout/pkg/validation"
+)
+
+func TestEncodeError(t *testing.T) {
+	violations := []validation.Violation{
+		{Field: "username", Description: "value length must be at least 3 runes"},
+		{Field: "pagination.page_size", Description: "value must be greater than 0"},
+	}
+	tests := []struct {
+		name string
+		err  error
+		want problem
+	}{
+		{
+			name: "invalid request",
+			err:  validation.NewError(violations),
+			want: problem{
+				Type:       "about:blank",
+				Title:      "Bad Request",
+				Status:     400,
+				Detail:     "invalid request: username: value length must be at least 3 runes; pagination.page_size: value must be greater than 0",
+				Instance:   "/users",
+				Reason:     validation.Reason,
+				Violations: violations,
+			},
+		},
+		{
+			name: "error reason with metadata",
+			err:  usersV1.ErrorUserAlreadyExists("email is already taken").WithMetadata(map[string]string{"field": "email"}),
+			want: problem{
+				Type:     "about:blank",
+				Title:    "Conflict",
+				Status:   409,
+				Detail:   "email is already taken",
+				Instance: "/users",
+				Reason:   usersV1.ErrorReason_USER_ALREADY_EXISTS.String(),
+				Metadata: map[string]string{"field": "email"},
+			},
+		},
+		{
+			name: "raw error",
+			err:  fmt.Errorf("connection refused"),
+			want: problem{
+				Type:     "about:blank",
+				Title:    "Internal Server Error",
+				Status:   500,
+				Detail:   "connection refused",
+				Instance: "/users",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			encodeError(rec, httptest.NewRequest("POST", "/users?debug=1", nil), tt.err)
+
+			if rec.Code != tt.want.Status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want.Status)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+				t.Errorf("Content-Type = %q, want application/problem+json", ct)
+			}
+			var got problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("body = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
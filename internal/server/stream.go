@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// streamMiddleware runs m around the calls of streaming RPCs the way
+// grpc.Middleware does for unary ones, the first message standing for the
+// request. The kratos StreamMiddleware only wraps every message sent and
+// received, too late to recover a handler.
+// Bidirectional streams, e.g. the reflection service, have no request and
+// are left alone.
+func streamMiddleware(m ...middleware.Middleware) grpc.StreamServerInterceptor {
+	chain := middleware.Chain(m...)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream && info.IsServerStream {
+			return handler(srv, ss)
+		}
+		req := streamRequest(info.FullMethod)
+		if req == nil {
+			return handler(srv, ss)
+		}
+		rs := &replayStream{ServerStream: ss, req: req}
+		var in interface{} = req
+		if rs.err = ss.RecvMsg(req); rs.err == io.EOF {
+			// an empty client stream is the handler's to report
+			in = nil
+		} else if rs.err != nil {
+			return rs.err
+		}
+		_, err := chain(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			rs.ctx = ctx
+			return nil, handler(srv, rs)
+		})(ss.Context(), in)
+		return err
+	}
+}
+
+// streamRequest returns a new request message of the method named
+// /package.Service/Method, nil when it is not registered.
+func streamRequest(fullMethod string) proto.Message {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil
+	}
+	return mt.New().Interface()
+}
+
+// replayStream hands the request read by streamMiddleware to the first
+// RecvMsg of the handler, under the context of the middleware.
+type replayStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	req      proto.Message
+	err      error
+	replayed bool
+}
+
+func (s *replayStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *replayStream) RecvMsg(m interface{}) error {
+	if s.replayed {
+		return s.ServerStream.RecvMsg(m)
+	}
+	s.replayed = true
+	if s.err != nil {
+		return s.err
+	}
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	productsV1 "layout/api/products/v1"
+	usersV1 "layout/api/users/v1"
+	"layout/internal/biz"
+	"layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/proto"
+)
+
+// usersRepoStub answers GetByIDs with items, the other methods are not
+// expected to be called.
+type usersRepoStub struct {
+	biz.UsersRepo
+	items []biz.BatchItem[*biz.User]
+	calls int
+}
+
+func (r *usersRepoStub) GetByIDs(context.Context, []string) ([]biz.BatchItem[*biz.User], error) {
+	r.calls++
+	return r.items, nil
+}
+
+// productsRepoStub answers DeleteMany with items.
+type productsRepoStub struct {
+	biz.ProductsRepo
+	items []biz.BatchItem[string]
+	calls int
+}
+
+func (r *productsRepoStub) DeleteMany(context.Context, []string, bool) ([]biz.BatchItem[string], error) {
+	r.calls++
+	return r.items, nil
+}
+
+func newTestServices(t *testing.T, c *conf.Server, users biz.UsersRepo, products biz.ProductsRepo) (*UsersService, *ProductsService) {
+	t.Helper()
+	metrics, err := biz.NewMetrics(metricnoop.NewMeterProvider().Meter("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger, tracer := log.NewStdLogger(io.Discard), tracenoop.NewTracerProvider().Tracer("test")
+	return NewUsersService(biz.NewUsersUsecase(users, nil, metrics, logger, tracer), c, logger, tracer),
+		NewProductsService(biz.NewProductsUsecase(products, nil, nil, metrics, logger, tracer), c, logger, tracer)
+}
+
+func TestMaxBatchSize(t *testing.T) {
+	tests := []struct {
+		c    *conf.Server
+		want int
+	}{
+		{nil, defaultMaxBatchSize},
+		{&conf.Server{Batch: &conf.Server_Batch{}}, defaultMaxBatchSize},
+		{&conf.Server{Batch: &conf.Server_Batch{MaxSize: 10}}, 10},
+	}
+	for _, tt := range tests {
+		if got := maxBatchSize(tt.c); got != tt.want {
+			t.Errorf("maxBatchSize(%v) = %d, want %d", tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestBatchGetUsers(t *testing.T) {
+	ada := &biz.User{ID: "1", Username: "ada", Email: "ada@example.com", Phone: "+3312345678"}
+	repo := &usersRepoStub{items: []biz.BatchItem[*biz.User]{
+		{Value: ada},
+		{Err: usersV1.ErrorUserInvalidArgument("invalid user id %q", "x")},
+		{Err: usersV1.ErrorUserNotFound("user %s not found", "3")},
+	}}
+	users, _ := newTestServices(t, &conf.Server{Batch: &conf.Server_Batch{MaxSize: 3}}, repo, nil)
+	ctx := context.Background()
+
+	_, err := users.BatchGetUsers(ctx, &usersV1.BatchGetUsersRequest{Ids: []string{"1", "2", "3", "4"}})
+	if !usersV1.IsUserInvalidArgument(err) || repo.calls != 0 {
+		t.Errorf("over the limit: err = %v after %d repo calls, want USER_INVALID_ARGUMENT before any", err, repo.calls)
+	}
+
+	res, err := users.BatchGetUsers(ctx, &usersV1.BatchGetUsersRequest{Ids: []string{"1", "x", "3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*usersV1.BatchGetUsersResponse_Result{
+		{Id: "1", Outcome: &usersV1.BatchGetUsersResponse_Result_User{User: &usersV1.User{
+			Id: "1", Username: "ada", Email: "ada@example.com", Phone: "+3312345678", Picture: proto.String(""),
+		}}},
+		{Id: "x", Outcome: &usersV1.BatchGetUsersResponse_Result_Error{Error: &usersV1.BatchError{
+			Code: 400, Reason: usersV1.ErrorReason_USER_INVALID_ARGUMENT.String(), Message: `invalid user id "x"`,
+		}}},
+		{Id: "3", Outcome: &usersV1.BatchGetUsersResponse_Result_Error{Error: &usersV1.BatchError{
+			Code: 404, Reason: usersV1.ErrorReason_USER_NOT_FOUND.String(), Message: "user 3 not found",
+		}}},
+	}
+	if !proto.Equal(res, &usersV1.BatchGetUsersResponse{Results: want}) {
+		t.Errorf("results = %v, want %v", res.GetResults(), want)
+	}
+
+	_, err = users.BatchGetUsers(ctx, &usersV1.BatchGetUsersRequest{Ids: []string{"1", "x", "3"}, Atomic: true})
+	if se := errors.FromError(err); !usersV1.IsUserInvalidArgument(err) || !strings.HasPrefix(se.Message, "item 1: ") {
+		t.Errorf("atomic batch: err = %v, want the USER_INVALID_ARGUMENT of item 1", err)
+	}
+}
+
+func TestBatchDeleteProducts(t *testing.T) {
+	repo := &productsRepoStub{items: []biz.BatchItem[string]{
+		{Value: "a"},
+		{Value: "b", Err: productsV1.ErrorProductNotFound("product %s not found", "b")},
+	}}
+	_, products := newTestServices(t, nil, nil, repo)
+	ctx := context.Background()
+
+	_, err := products.BatchDeleteProducts(ctx, &productsV1.BatchDeleteProductsRequest{Ids: make([]string, defaultMaxBatchSize+1)})
+	if !productsV1.IsProductInvalidArgument(err) || repo.calls != 0 {
+		t.Errorf("over the default limit: err = %v after %d repo calls, want PRODUCT_INVALID_ARGUMENT before any", err, repo.calls)
+	}
+
+	res, err := products.BatchDeleteProducts(ctx, &productsV1.BatchDeleteProductsRequest{Ids: []string{"a", "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &productsV1.BatchDeleteProductsResponse{Results: []*productsV1.BatchDeleteProductsResponse_Result{
+		{Id: "a"},
+		{Id: "b", Error: &productsV1.BatchError{Code: 404, Reason: productsV1.ErrorReason_PRODUCT_NOT_FOUND.String(), Message: "product b not found"}},
+	}}
+	if !proto.Equal(res, want) {
+		t.Errorf("results = %v, want %v", res.GetResults(), want.GetResults())
+	}
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+
+	pb "layout/api/products/v1"
+	"layout/internal/biz"
+	"layout/pkg/validation"
+)
+
+// Catalogue files hold one product per row. CSV files start with a header
+// naming their columns in any order; tags and images hold several values
+// separated by "|" and attributes "key=value" pairs separated the same way.
+// Unknown columns, such as the id of an export, are ignored on import.
+var catalogueColumns = []string{"id", "name", "description", "price", "category", "tags", "attributes", "thumbnail", "images", "sku"}
+
+const (
+	catalogueListSep = "|"
+	// maxNDJSONLine bounds a single NDJSON product.
+	maxNDJSONLine = 1 << 20
+)
+
+// newCatalogueSource decodes the catalogue in r, failing right away on a CSV
+// header that lacks a required column.
+func newCatalogueSource(format pb.FileFormat, r io.ReadCloser) (biz.ImportSource, error) {
+	switch format {
+	case pb.FileFormat_FILE_FORMAT_CSV:
+		return newCSVSource(r)
+	case pb.FileFormat_FILE_FORMAT_NDJSON:
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 0, 64<<10), maxNDJSONLine)
+		return &ndjsonSource{closer: r, sc: sc}, nil
+	}
+	r.Close()
+	return nil, pb.ErrorProductInvalidArgument("unsupported file format %s", format)
+}
+
+type csvSource struct {
+	closer  io.Closer
+	r       *csv.Reader
+	columns map[string]int
+	err     error
+}
+
+func newCSVSource(r io.ReadCloser) (*csvSource, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		r.Close()
+		if err == io.EOF {
+			return nil, pb.ErrorProductInvalidArgument("empty CSV file")
+		}
+		return nil, pb.ErrorProductInvalidArgument("invalid CSV header: %s", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"name", "description", "price"} {
+		if _, ok := columns[name]; !ok {
+			r.Close()
+			return nil, pb.ErrorProductInvalidArgument("CSV header lacks the %s column", name)
+		}
+	}
+	return &csvSource{closer: r, r: cr, columns: columns}, nil
+}
+
+func (s *csvSource) Next() (biz.ImportRow, bool) {
+	record, err := s.r.Read()
+	if err == io.EOF {
+		return biz.ImportRow{}, false
+	}
+	var pe *csv.ParseError
+	if errors.As(err, &pe) {
+		return biz.ImportRow{Line: pe.StartLine, Errors: []biz.ImportError{{Line: pe.StartLine, Message: pe.Err.Error()}}}, true
+	}
+	if err != nil {
+		s.err = err
+		return biz.ImportRow{}, false
+	}
+	line, _ := s.r.FieldPos(0)
+	cell := func(name string) string {
+		if i, ok := s.columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	req := &pb.CreateProductRequest{
+		Name:        cell("name"),
+		Description: cell("description"),
+		Tags:        splitList(cell("tags")),
+		Images:      splitList(cell("images")),
+		Attributes:  map[string]string{},
+	}
+	if v := cell("category"); v != "" {
+		req.Category = &v
+	}
+	if v := cell("thumbnail"); v != "" {
+		req.Thumbnail = &v
+	}
+	if v := cell("sku"); v != "" {
+		req.Sku = &v
+	}
+	var rejected []biz.ImportError
+	if v := cell("price"); v != "" {
+		price, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			rejected = append(rejected, biz.ImportError{Line: line, Field: "price", Message: fmt.Sprintf("%q is not a number", v)})
+		}
+		req.Price = float32(price)
+	}
+	for _, pair := range splitList(cell("attributes")) {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			rejected = append(rejected, biz.ImportError{Line: line, Field: "attributes", Message: fmt.Sprintf("%q is not a key=value pair", pair)})
+			continue
+		}
+		req.Attributes[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return importRow(line, req, rejected), true
+}
+
+func (s *csvSource) Err() error {
+	if s.err != nil {
+		return fmt.Errorf("read CSV: %w", s.err)
+	}
+	return nil
+}
+
+func (s *csvSource) Close() error {
+	return s.closer.Close()
+}
+
+type ndjsonSource struct {
+	closer io.Closer
+	sc     *bufio.Scanner
+	line   int
+}
+
+func (s *ndjsonSource) Next() (biz.ImportRow, bool) {
+	for s.sc.Scan() {
+		s.line++
+		raw := bytes.TrimSpace(s.sc.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		req := &pb.CreateProductRequest{}
+		if err := encoding.GetCodec(json.Name).Unmarshal(raw, req); err != nil {
+			return biz.ImportRow{Line: s.line, Errors: []biz.ImportError{{Line: s.line, Message: err.Error()}}}, true
+		}
+		return importRow(s.line, req, nil), true
+	}
+	return biz.ImportRow{}, false
+}
+
+func (s *ndjsonSource) Err() error {
+	if err := s.sc.Err(); err != nil {
+		return fmt.Errorf("read NDJSON line %d: %w", s.line+1, err)
+	}
+	return nil
+}
+
+func (s *ndjsonSource) Close() error {
+	return s.closer.Close()
+}
+
+// importRow validates req against the rules of CreateProduct, adding its
+// violations to the ones found while decoding the row. Fields that could not
+// be decoded are not reported twice.
+func importRow(line int, req *pb.CreateProductRequest, rejected []biz.ImportError) biz.ImportRow {
+	undecoded := make(map[string]bool, len(rejected))
+	for _, e := range rejected {
+		undecoded[e.Field] = true
+	}
+	for _, v := range validation.Violations(req) {
+		if !undecoded[v.Field] {
+			rejected = append(rejected, biz.ImportError{Line: line, Field: v.Field, Message: v.Description})
+		}
+	}
+	if len(rejected) > 0 {
+		return biz.ImportRow{Line: line, Errors: rejected}
+	}
+	return biz.ImportRow{Line: line, Product: newProduct(req)}
+}
+
+func splitList(cell string) []string {
+	var values []string
+	for _, v := range strings.Split(cell, catalogueListSep) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// catalogueWriter encodes exported products in a catalogue format.
+type catalogueWriter interface {
+	Write(p *pb.Product) error
+	Flush() error
+}
+
+func newCatalogueWriter(format pb.FileFormat, w io.Writer) (catalogueWriter, error) {
+	switch format {
+	case pb.FileFormat_FILE_FORMAT_CSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(catalogueColumns); err != nil {
+			return nil, err
+		}
+		return csvWriter{cw}, nil
+	case pb.FileFormat_FILE_FORMAT_NDJSON:
+		return &ndjsonWriter{bufio.NewWriter(w)}, nil
+	}
+	return nil, pb.ErrorProductInvalidArgument("unsupported file format %s", format)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c csvWriter) Write(p *pb.Product) error {
+	attributes := make([]string, 0, len(p.GetAttributes()))
+	for _, key := range slices.Sorted(maps.Keys(p.GetAttributes())) {
+		attributes = append(attributes, key+"="+p.GetAttributes()[key])
+	}
+	return c.w.Write([]string{
+		p.GetId(),
+		p.GetName(),
+		p.GetDescription(),
+		strconv.FormatFloat(float64(p.GetPrice()), 'f', -1, 32),
+		p.GetCategory(),
+		strings.Join(p.GetTags(), catalogueListSep),
+		strings.Join(attributes, catalogueListSep),
+		p.GetThumbnail(),
+		strings.Join(p.GetImages(), catalogueListSep),
+		p.GetSku(),
+	})
+}
+
+func (c csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonWriter struct {
+	w *bufio.Writer
+}
+
+func (n *ndjsonWriter) Write(p *pb.Product) error {
+	raw, err := encoding.GetCodec(json.Name).Marshal(p)
+	if err != nil {
+		return err
+	}
+	if _, err := n.w.Write(raw); err != nil {
+		return err
+	}
+	return n.w.WriteByte('\n')
+}
+
+func (n *ndjsonWriter) Flush() error {
+	return n.w.Flush()
+}
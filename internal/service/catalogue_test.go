@@ -0,0 +1,169 @@
+package service
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "layout/api/products/v1"
+	"layout/internal/biz"
+)
+
+// importedRow is a decoded row with its errors reduced to their field.
+type importedRow struct {
+	line    int
+	product *biz.Product
+	fields  []string
+}
+
+func readCatalogue(t *testing.T, format pb.FileFormat, body string) []importedRow {
+	t.Helper()
+	src, err := newCatalogueSource(format, io.NopCloser(strings.NewReader(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+	var rows []importedRow
+	for {
+		row, ok := src.Next()
+		if !ok {
+			break
+		}
+		r := importedRow{line: row.Line, product: row.Product}
+		for _, e := range row.Errors {
+			if e.Line != row.Line {
+				t.Errorf("error %+v reported on line %d", e, row.Line)
+			}
+			r.fields = append(r.fields, e.Field)
+		}
+		rows = append(rows, r)
+	}
+	if err := src.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+func TestCSVSource(t *testing.T) {
+	thumbnail, sku := "https://img/1.png", "LMP-1"
+	tests := []struct {
+		name string
+		body string
+		want []importedRow
+	}{
+		{
+			name: "every column",
+			body: "id,name,description,price,category,tags,attributes,thumbnail,images,sku\n" +
+				`42,Desk lamp,A small lamp,19.5,lighting,desk | led,color=red|size = m,https://img/1.png,https://img/2.png|https://img/3.png,LMP-1` + "\n",
+			want: []importedRow{{line: 2, product: &biz.Product{
+				Name:        "Desk lamp",
+				Description: "A small lamp",
+				Price:       19.5,
+				Category:    "lighting",
+				Tags:        []string{"desk", "led"},
+				Attributes:  map[string]string{"color": "red", "size": "m"},
+				Thumbnail:   &thumbnail,
+				Images:      []string{"https://img/2.png", "https://img/3.png"},
+				SKU:         sku,
+			}}},
+		},
+		{
+			name: "columns in any order",
+			body: "Price, Description ,name\n5,Plain mug,Mug\n",
+			want: []importedRow{{line: 2, product: &biz.Product{
+				Name:        "Mug",
+				Description: "Plain mug",
+				Price:       5,
+				Attributes:  map[string]string{},
+			}}},
+		},
+		{
+			name: "undecodable cells",
+			body: "name,description,price,attributes\nMug,Plain mug,cheap,color\n",
+			want: []importedRow{{line: 2, fields: []string{"price", "attributes"}}},
+		},
+		{
+			name: "rule violations",
+			body: "name,description,price\nMu,Plain mug,0\nMug,Plain mug,3\n",
+			want: []importedRow{
+				{line: 2, fields: []string{"name", "price"}},
+				{line: 3, product: &biz.Product{Name: "Mug", Description: "Plain mug", Price: 3, Attributes: map[string]string{}}},
+			},
+		},
+		{
+			name: "malformed row",
+			body: "name,description,price\n\"Mug,Plain mug,3\n",
+			want: []importedRow{{line: 2, fields: []string{""}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := readCatalogue(t, pb.FileFormat_FILE_FORMAT_CSV, tt.body)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNDJSONSource(t *testing.T) {
+	category := "kitchen"
+	tests := []struct {
+		name string
+		body string
+		want []importedRow
+	}{
+		{
+			name: "products",
+			body: `{"name":"Mug","description":"Plain mug","price":5,"category":"kitchen","tags":["tea"]}` + "\n\n" +
+				`{"name":"Bowl","description":"Deep bowl","price":7.5,"attributes":{"color":"blue"}}`,
+			want: []importedRow{
+				{line: 1, product: &biz.Product{Name: "Mug", Description: "Plain mug", Price: 5, Category: category, Tags: []string{"tea"}}},
+				{line: 3, product: &biz.Product{Name: "Bowl", Description: "Deep bowl", Price: 7.5, Attributes: map[string]string{"color": "blue"}}},
+			},
+		},
+		{
+			name: "rule violations",
+			body: `{"name":"Mug","description":"Plain mug","price":-1}`,
+			want: []importedRow{{line: 1, fields: []string{"price"}}},
+		},
+		{
+			name: "invalid JSON",
+			body: "{\"name\":\n" + `{"name":"Mug","description":"Plain mug","price":5}`,
+			want: []importedRow{
+				{line: 1, fields: []string{""}},
+				{line: 2, product: &biz.Product{Name: "Mug", Description: "Plain mug", Price: 5}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := readCatalogue(t, pb.FileFormat_FILE_FORMAT_NDJSON, tt.body)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCatalogueSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  pb.FileFormat
+		body    string
+		wantErr string
+	}{
+		{"empty CSV", pb.FileFormat_FILE_FORMAT_CSV, "", "empty CSV file"},
+		{"missing column", pb.FileFormat_FILE_FORMAT_CSV, "name,description\n", "lacks the price column"},
+		{"unsupported format", pb.FileFormat_FILE_FORMAT_UNSPECIFIED, "", "unsupported file format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newCatalogueSource(tt.format, io.NopCloser(strings.NewReader(tt.body)))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("newCatalogueSource() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
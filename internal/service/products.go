@@ -3,35 +3,42 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	pb "layout/api/products/v1"
 	"layout/internal/biz"
+	"layout/internal/conf"
+	"layout/pkg/monitor"
+	"layout/pkg/validation"
 )
 
 type ProductsService struct {
 	pb.UnimplementedProductsServer
-	uc  *biz.ProductsUsecase
-	log *log.Helper
+	uc        *biz.ProductsUsecase
+	maxBatch  int
+	maxImport int64
+	log       *log.Helper
+	tracer    trace.Tracer
 }
 
-func NewProductsService(uc *biz.ProductsUsecase, logger log.Logger) *ProductsService {
+func NewProductsService(uc *biz.ProductsUsecase, c *conf.Server, logger log.Logger, tracer trace.Tracer) *ProductsService {
 	return &ProductsService{
-		uc:  uc,
-		log: log.NewHelper(logger),
+		uc:        uc,
+		maxBatch:  maxBatchSize(c),
+		maxImport: maxImportBytes(c),
+		log:       log.NewHelper(logger),
+		tracer:    tracer,
 	}
 }
 
-func (s *ProductsService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
-	_, span := otel.Tracer("products").Start(ctx, "CreateProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "product",
-		Value: attribute.StringValue(fmt.Sprintf("Name: %s, Desc %s, Category: %s, Price: %f", req.GetName(), req.GetDescription(), req.GetCategory(), req.GetPrice())),
-	})
+func (s *ProductsService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (_ *pb.CreateProductResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.CreateProduct", biz.AttrProductCategory.String(req.GetCategory()))
+	defer func() { monitor.EndSpan(span, err) }()
 	bizProd := &biz.Product{
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
@@ -40,6 +47,7 @@ func (s *ProductsService) CreateProduct(ctx context.Context, req *pb.CreateProdu
 		Tags:        req.GetTags(),
 		Attributes:  req.GetAttributes(),
 		Images:      req.GetImages(),
+		SKU:         req.GetSku(),
 	}
 	thumbnail := req.GetThumbnail()
 	if thumbnail != "" {
@@ -54,13 +62,9 @@ func (s *ProductsService) CreateProduct(ctx context.Context, req *pb.CreateProdu
 	}
 	return resp, nil
 }
-func (s *ProductsService) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
-	_, span := otel.Tracer("products").Start(ctx, "GetProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(req.GetId()),
-	})
+func (s *ProductsService) GetProduct(ctx context.Context, req *pb.GetProductRequest) (_ *pb.GetProductResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.GetProduct", biz.AttrProductID.String(req.GetId()))
+	defer func() { monitor.EndSpan(span, err) }()
 	res, err := s.uc.GetProduct(ctx, req.GetId())
 	if err != nil {
 		return nil, err
@@ -75,19 +79,16 @@ func (s *ProductsService) GetProduct(ctx context.Context, req *pb.GetProductRequ
 		Attributes:  res.Attributes,
 		Thumbnail:   res.Thumbnail,
 		Images:      res.Images,
+		Sku:         optionalString(res.SKU),
 	}
 	resp := &pb.GetProductResponse{
 		Product: result,
 	}
 	return resp, nil
 }
-func (s *ProductsService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
-	_, span := otel.Tracer("products").Start(ctx, "ListProducts")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", req.GetPagination().GetPage(), req.GetPagination().GetPageSize())),
-	})
+func (s *ProductsService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (_ *pb.ListProductsResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.ListProducts")
+	defer func() { monitor.EndSpan(span, err) }()
 	var page int32 = 0
 	var pageSize int32 = 10
 	reqPagination := req.GetPagination()
@@ -101,7 +102,16 @@ func (s *ProductsService) ListProducts(ctx context.Context, req *pb.ListProducts
 		Size: pageSize,
 	}
 
-	res, err := s.uc.ListProducts(ctx, pagination)
+	filter := &biz.ProductFilter{
+		Category:     req.Category,
+		Tags:         req.Tags,
+		AllTags:      req.TagMatch == pb.TagMatch_TAG_MATCH_ALL,
+		MinPrice:     req.MinPrice,
+		MaxPrice:     req.MaxPrice,
+		Attributes:   req.Attributes,
+		HasThumbnail: req.HasThumbnail,
+	}
+	res, err := s.uc.ListProducts(ctx, filter, productOrder(req.OrderBy), pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +127,7 @@ func (s *ProductsService) ListProducts(ctx context.Context, req *pb.ListProducts
 			Attributes:  p.Attributes,
 			Thumbnail:   p.Thumbnail,
 			Images:      p.Images,
+			Sku:         optionalString(p.SKU),
 		})
 	}
 	resp := &pb.ListProductsResponse{
@@ -124,13 +135,27 @@ func (s *ProductsService) ListProducts(ctx context.Context, req *pb.ListProducts
 	}
 	return resp, nil
 }
-func (s *ProductsService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
-	_, span := otel.Tracer("products").Start(ctx, "UpdateProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "product",
-		Value: attribute.StringValue(fmt.Sprintf("Name: %s, Desc %s, Category: %s, Price: %f", req.GetName(), req.GetDescription(), req.GetCategory(), req.GetPrice())),
-	})
+
+// productOrder parses an order_by the request validation already matched,
+// e.g. "price desc, name".
+func productOrder(orderBy string) []biz.ProductOrder {
+	var order []biz.ProductOrder
+	for _, key := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(key)
+		if len(fields) == 0 {
+			continue
+		}
+		order = append(order, biz.ProductOrder{
+			Field: biz.ProductSortField(fields[0]),
+			Desc:  len(fields) > 1 && fields[1] == "desc",
+		})
+	}
+	return order
+}
+
+func (s *ProductsService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (_ *pb.UpdateProductResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.UpdateProduct", biz.AttrProductID.String(req.GetId()))
+	defer func() { monitor.EndSpan(span, err) }()
 	bizProd := &biz.Product{
 		ID:          req.GetId(),
 		Name:        req.GetName(),
@@ -140,6 +165,7 @@ func (s *ProductsService) UpdateProduct(ctx context.Context, req *pb.UpdateProdu
 		Tags:        req.GetTags(),
 		Attributes:  req.GetAttributes(),
 		Images:      req.GetImages(),
+		SKU:         req.GetSku(),
 	}
 	thumbnail := req.GetThumbnail()
 	if thumbnail != "" {
@@ -154,13 +180,9 @@ func (s *ProductsService) UpdateProduct(ctx context.Context, req *pb.UpdateProdu
 	}
 	return resp, nil
 }
-func (s *ProductsService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
-	_, span := otel.Tracer("products").Start(ctx, "DeleteProduct")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "id",
-		Value: attribute.StringValue(req.GetId()),
-	})
+func (s *ProductsService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (_ *pb.DeleteProductResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.DeleteProduct", biz.AttrProductID.String(req.GetId()))
+	defer func() { monitor.EndSpan(span, err) }()
 	res, err := s.uc.DeleteProduct(ctx, req.GetId())
 	if err != nil {
 		return nil, err
@@ -170,17 +192,9 @@ func (s *ProductsService) DeleteProduct(ctx context.Context, req *pb.DeleteProdu
 	}
 	return resp, nil
 }
-func (s *ProductsService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
-	_, span := otel.Tracer("products").Start(ctx, "SearchProducts")
-	defer span.End()
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "keyword",
-		Value: attribute.StringValue(req.GetQuery()),
-	})
-	span.SetAttributes(attribute.KeyValue{
-		Key:   "pagination",
-		Value: attribute.StringValue(fmt.Sprintf("Page: %d Size: %d", req.GetPagination().GetPage(), req.GetPagination().GetPageSize())),
-	})
+func (s *ProductsService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (_ *pb.SearchProductsResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.SearchProducts", biz.AttrSearchQuery.String(req.GetQuery()))
+	defer func() { monitor.EndSpan(span, err) }()
 	var page int32 = 0
 	var pageSize int32 = 10
 	reqPagination := req.GetPagination()
@@ -194,26 +208,210 @@ func (s *ProductsService) SearchProducts(ctx context.Context, req *pb.SearchProd
 		Size: pageSize,
 	}
 
-	res, err := s.uc.SearchProducts(ctx, req.GetQuery(), pagination)
+	for i := 1; i < len(req.PriceBuckets); i++ {
+		if req.PriceBuckets[i] <= req.PriceBuckets[i-1] {
+			return nil, validation.NewError([]validation.Violation{{
+				Field:       fmt.Sprintf("price_buckets[%d]", i),
+				Description: "value must be greater than the previous bucket",
+			}})
+		}
+	}
+	search := &biz.ProductSearch{
+		Query: req.GetQuery(),
+		Filter: &biz.ProductFilter{
+			Category:   req.Category,
+			Tags:       req.Tags,
+			AllTags:    req.TagMatch == pb.TagMatch_TAG_MATCH_ALL,
+			MinPrice:   req.MinPrice,
+			MaxPrice:   req.MaxPrice,
+			Attributes: req.Attributes,
+		},
+		Order:        productOrder(req.OrderBy),
+		PriceBuckets: req.PriceBuckets,
+	}
+	res, err := s.uc.SearchProducts(ctx, search, pagination)
 	if err != nil {
 		return nil, err
 	}
-	var products []*pb.Product
-	for _, p := range res {
-		products = append(products, &pb.Product{
-			Id:          p.ID,
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-			Category:    p.Category,
-			Tags:        p.Tags,
-			Attributes:  p.Attributes,
-			Thumbnail:   p.Thumbnail,
-			Images:      p.Images,
-		})
-	}
 	resp := &pb.SearchProductsResponse{
-		Products: products,
+		Products: make([]*pb.Product, 0, len(res.Hits)),
+		Matches:  make([]*pb.SearchMatch, 0, len(res.Hits)),
+		Facets:   facetsProto(&res.Facets),
+		Total:    res.Total,
+	}
+	for _, hit := range res.Hits {
+		resp.Products = append(resp.Products, productProto(hit.Product))
+		match := &pb.SearchMatch{Score: hit.Score}
+		for _, h := range hit.Highlights {
+			match.Highlights = append(match.Highlights, &pb.Highlight{Field: h.Field, Snippet: h.Snippet})
+		}
+		resp.Matches = append(resp.Matches, match)
+	}
+	return resp, nil
+}
+
+// suggestionKinds maps the suggestion kinds to their proto enum.
+var suggestionKinds = map[biz.SuggestionKind]pb.Suggestion_Kind{
+	biz.SuggestionName: pb.Suggestion_NAME,
+	biz.SuggestionTag:  pb.Suggestion_TAG,
+}
+
+func (s *ProductsService) SuggestProducts(ctx context.Context, req *pb.SuggestProductsRequest) (_ *pb.SuggestProductsResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.SuggestProducts", biz.AttrSearchQuery.String(req.GetPrefix()))
+	defer func() { monitor.EndSpan(span, err) }()
+	res, err := s.uc.SuggestProducts(ctx, req.GetPrefix(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.SuggestProductsResponse{Suggestions: make([]*pb.Suggestion, 0, len(res))}
+	for _, sg := range res {
+		resp.Suggestions = append(resp.Suggestions, &pb.Suggestion{Text: sg.Text, Kind: suggestionKinds[sg.Kind]})
 	}
 	return resp, nil
 }
+
+func facetsProto(f *biz.ProductFacets) *pb.Facets {
+	counts := func(values []biz.FacetCount) []*pb.Facets_Count {
+		res := make([]*pb.Facets_Count, 0, len(values))
+		for _, v := range values {
+			res = append(res, &pb.Facets_Count{Value: v.Value, Count: v.Count})
+		}
+		return res
+	}
+	res := &pb.Facets{
+		Categories: counts(f.Categories),
+		Tags:       counts(f.Tags),
+	}
+	for _, b := range f.Prices {
+		res.Prices = append(res.Prices, &pb.Facets_PriceBucket{Min: b.Min, Max: b.Max, Count: b.Count})
+	}
+	for _, a := range f.Attributes {
+		res.Attributes = append(res.Attributes, &pb.Facets_Attribute{Key: a.Key, Values: counts(a.Values)})
+	}
+	return res
+}
+
+func (s *ProductsService) BatchCreateProducts(ctx context.Context, req *pb.BatchCreateProductsRequest) (_ *pb.BatchCreateProductsResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.BatchCreateProducts", biz.AttrBatchSize.Int(len(req.GetProducts())))
+	defer func() { monitor.EndSpan(span, err) }()
+	if len(req.GetProducts()) > s.maxBatch {
+		return nil, pb.ErrorProductInvalidArgument("at most %d products per batch, got %d", s.maxBatch, len(req.GetProducts()))
+	}
+	products := make([]*biz.Product, 0, len(req.GetProducts()))
+	for _, p := range req.GetProducts() {
+		products = append(products, newProduct(p))
+	}
+	items, err := s.uc.BatchCreateProducts(ctx, products, req.GetAtomic())
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*pb.BatchCreateProductsResponse_Result, 0, len(items))
+	for _, item := range items {
+		result := &pb.BatchCreateProductsResponse_Result{}
+		if item.Err != nil {
+			result.Outcome = &pb.BatchCreateProductsResponse_Result_Error{Error: productBatchError(item.Err)}
+		} else {
+			result.Outcome = &pb.BatchCreateProductsResponse_Result_Id{Id: item.Value}
+		}
+		results = append(results, result)
+	}
+	return &pb.BatchCreateProductsResponse{Results: results}, nil
+}
+
+func (s *ProductsService) BatchDeleteProducts(ctx context.Context, req *pb.BatchDeleteProductsRequest) (_ *pb.BatchDeleteProductsResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.BatchDeleteProducts", biz.AttrBatchSize.Int(len(req.GetIds())))
+	defer func() { monitor.EndSpan(span, err) }()
+	if len(req.GetIds()) > s.maxBatch {
+		return nil, pb.ErrorProductInvalidArgument("at most %d ids per batch, got %d", s.maxBatch, len(req.GetIds()))
+	}
+	items, err := s.uc.BatchDeleteProducts(ctx, req.GetIds(), req.GetAtomic())
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*pb.BatchDeleteProductsResponse_Result, 0, len(items))
+	for _, item := range items {
+		result := &pb.BatchDeleteProductsResponse_Result{Id: item.Value}
+		if item.Err != nil {
+			result.Error = productBatchError(item.Err)
+		}
+		results = append(results, result)
+	}
+	return &pb.BatchDeleteProductsResponse{Results: results}, nil
+}
+
+// productBatchError converts the error of a batch item to its API form.
+func productBatchError(err error) *pb.BatchError {
+	se := errors.FromError(err)
+	return &pb.BatchError{Code: se.Code, Reason: se.Reason, Message: se.Message}
+}
+
+// newProduct converts a create request to the product to save.
+func newProduct(req *pb.CreateProductRequest) *biz.Product {
+	product := &biz.Product{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.GetPrice(),
+		Category:    req.GetCategory(),
+		Tags:        req.GetTags(),
+		Attributes:  req.GetAttributes(),
+		Images:      req.GetImages(),
+		SKU:         req.GetSku(),
+	}
+	if thumbnail := req.GetThumbnail(); thumbnail != "" {
+		product.Thumbnail = &thumbnail
+	}
+	return product
+}
+
+// productProto converts a product to its API form.
+func productProto(p *biz.Product) *pb.Product {
+	return &pb.Product{
+		Id:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price,
+		Category:    p.Category,
+		Tags:        p.Tags,
+		Attributes:  p.Attributes,
+		Thumbnail:   p.Thumbnail,
+		Images:      p.Images,
+		Sku:         optionalString(p.SKU),
+	}
+}
+
+// optionalString maps an empty string to an unset optional proto field.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+var productChangeTypes = map[biz.ChangeType]pb.ChangeType{
+	biz.ChangeCreated: pb.ChangeType_CREATED,
+	biz.ChangeUpdated: pb.ChangeType_UPDATED,
+	biz.ChangeDeleted: pb.ChangeType_DELETED,
+}
+
+func (s *ProductsService) WatchProducts(req *pb.WatchProductsRequest, stream pb.Products_WatchProductsServer) error {
+	return s.WatchProductChanges(stream.Context(), req, stream.Send)
+}
+
+// WatchProductChanges calls fn with every change of products, see
+// WatchProducts.
+func (s *ProductsService) WatchProductChanges(ctx context.Context, req *pb.WatchProductsRequest, fn func(*pb.ProductChange) error) (err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.WatchProducts")
+	defer func() { monitor.EndSpan(span, err) }()
+	return s.uc.WatchProducts(ctx, req.GetResumeToken(), func(c *biz.Change[*biz.Product]) error {
+		change := &pb.ProductChange{
+			Type:        productChangeTypes[c.Type],
+			Id:          c.ID,
+			Time:        c.Time.Format(time.RFC3339),
+			ResumeToken: c.ResumeToken,
+		}
+		if c.Value != nil {
+			change.Product = productProto(c.Value)
+		}
+		return fn(change)
+	})
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "layout/api/products/v1"
+	"layout/internal/biz"
+	"layout/pkg/monitor"
+)
+
+var importStatuses = map[biz.ImportStatus]pb.ImportJob_Status{
+	biz.ImportPending:   pb.ImportJob_PENDING,
+	biz.ImportRunning:   pb.ImportJob_RUNNING,
+	biz.ImportSucceeded: pb.ImportJob_SUCCEEDED,
+	biz.ImportFailed:    pb.ImportJob_FAILED,
+}
+
+func (s *ProductsService) ImportProducts(stream pb.Products_ImportProductsServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return pb.ErrorProductInvalidArgument("empty upload")
+	}
+	if err != nil {
+		return err
+	}
+	if first.GetOptions() == nil {
+		return pb.ErrorProductInvalidArgument("the first message must carry the import options")
+	}
+	job, err := s.ImportFile(stream.Context(), first.GetOptions(), &chunkReader{stream: stream})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(job)
+}
+
+// ImportFile stores the catalogue read from body and starts importing it.
+// The upload is read to the end before the job starts, so the job outlives
+// the request.
+func (s *ProductsService) ImportFile(ctx context.Context, opts *pb.ImportOptions, body io.Reader) (_ *pb.ImportJob, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.ImportFile", biz.AttrImportDryRun.Bool(opts.GetDryRun()))
+	defer func() { monitor.EndSpan(span, err) }()
+	upload, err := spoolUpload(body, s.maxImport)
+	if err != nil {
+		return nil, err
+	}
+	src, err := newCatalogueSource(opts.GetFormat(), upload)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.uc.StartImport(ctx, src, opts.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return importJobProto(job), nil
+}
+
+func (s *ProductsService) GetImportJob(ctx context.Context, req *pb.GetImportJobRequest) (_ *pb.ImportJob, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.GetImportJob", biz.AttrImportJobID.String(req.GetId()))
+	defer func() { monitor.EndSpan(span, err) }()
+	job, err := s.uc.GetImportJob(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return importJobProto(job), nil
+}
+
+func (s *ProductsService) ExportProducts(req *pb.ExportProductsRequest, stream pb.Products_ExportProductsServer) (err error) {
+	ctx, span := monitor.StartSpan(stream.Context(), s.tracer, "ProductsService.ExportProducts")
+	defer func() { monitor.EndSpan(span, err) }()
+	return s.uc.ExportProducts(ctx, productFilter(req), func(p *biz.Product) error {
+		return stream.Send(productProto(p))
+	})
+}
+
+// ExportFile writes the products matching req to w in format, flushing w as
+// the products are read.
+func (s *ProductsService) ExportFile(ctx context.Context, req *pb.ExportProductsRequest, format pb.FileFormat, w io.Writer) (err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "ProductsService.ExportFile")
+	defer func() { monitor.EndSpan(span, err) }()
+	out, err := newCatalogueWriter(format, w)
+	if err != nil {
+		return err
+	}
+	err = s.uc.ExportProducts(ctx, productFilter(req), func(p *biz.Product) error {
+		return out.Write(productProto(p))
+	})
+	if err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+func productFilter(req *pb.ExportProductsRequest) *biz.ProductFilter {
+	filter := &biz.ProductFilter{
+		Category: req.Category,
+		MinPrice: req.MinPrice,
+		MaxPrice: req.MaxPrice,
+	}
+	if req.Tag != nil {
+		filter.Tags = []string{*req.Tag}
+	}
+	return filter
+}
+
+func importJobProto(job *biz.ImportJob) *pb.ImportJob {
+	res := &pb.ImportJob{
+		Id:        job.ID,
+		Status:    importStatuses[job.Status],
+		DryRun:    job.DryRun,
+		Rows:      int32(job.Rows),
+		Imported:  int32(job.Imported),
+		Failed:    int32(job.Failed),
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.Format(time.RFC3339),
+	}
+	if !job.FinishedAt.IsZero() {
+		res.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+	for _, e := range job.Errors {
+		res.Errors = append(res.Errors, &pb.ImportJob_RowError{
+			Line:    int32(e.Line),
+			Field:   e.Field,
+			Message: e.Message,
+		})
+	}
+	return res
+}
+
+// chunkReader reads the file chunks following the options of an import
+// stream.
+type chunkReader struct {
+	stream pb.Products_ImportProductsServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetOptions() != nil {
+			return 0, pb.ErrorProductInvalidArgument("the import options must only be sent first")
+		}
+		r.buf = msg.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// spooledFile is a temporary copy of an upload, removed once closed.
+type spooledFile struct {
+	*os.File
+}
+
+func (f spooledFile) Close() error {
+	err := f.File.Close()
+	os.Remove(f.Name())
+	return err
+}
+
+// spoolUpload copies at most limit bytes of r to a temporary file, rewound
+// for reading.
+func spoolUpload(r io.Reader, limit int64) (io.ReadCloser, error) {
+	f, err := os.CreateTemp("", "products-import-*")
+	if err != nil {
+		return nil, pb.ErrorProductInternal("failed to store the upload").WithCause(err)
+	}
+	upload := spooledFile{f}
+	n, err := io.Copy(f, io.LimitReader(r, limit+1))
+	switch {
+	case err != nil && errors.FromError(err).Reason == "":
+		err = pb.ErrorProductInvalidArgument("failed to read the upload: %s", err)
+	case err == nil && n > limit:
+		err = pb.ErrorProductInvalidArgument("the upload exceeds %d bytes", limit)
+	case err == nil:
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		upload.Close()
+		return nil, err
+	}
+	return upload, nil
+}
@@ -1,6 +1,30 @@
 package service
 
-import "github.com/google/wire"
+import (
+	"layout/internal/conf"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is service providers.
 var ServiceProviderSet = wire.NewSet(NewUsersService, NewProductsService)
+
+// defaultMaxBatchSize applies when server.batch.max_size is unset.
+const defaultMaxBatchSize = 100
+
+func maxBatchSize(c *conf.Server) int {
+	if n := c.GetBatch().GetMaxSize(); n > 0 {
+		return int(n)
+	}
+	return defaultMaxBatchSize
+}
+
+// defaultMaxImportBytes applies when server.import.max_bytes is unset.
+const defaultMaxImportBytes = 64 << 20
+
+func maxImportBytes(c *conf.Server) int64 {
+	if n := c.GetImport().GetMaxBytes(); n > 0 {
+		return n
+	}
+	return defaultMaxImportBytes
+}
@@ -2,30 +2,39 @@ package service
 
 import (
 	"context"
+	"time"
 
 	pb "layout/api/users/v1"
 	"layout/internal/biz"
+	"layout/internal/conf"
+	"layout/pkg/monitor"
+	"layout/pkg/redact"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type UsersService struct {
 	pb.UnimplementedUsersServer
-	uc  *biz.UsersUsecase
-	log *log.Helper
+	uc       *biz.UsersUsecase
+	maxBatch int
+	log      *log.Helper
+	tracer   trace.Tracer
 }
 
-func NewUsersService(uc *biz.UsersUsecase, logger log.Logger) *UsersService {
+func NewUsersService(uc *biz.UsersUsecase, c *conf.Server, logger log.Logger, tracer trace.Tracer) *UsersService {
 	return &UsersService{
-		uc:  uc,
-		log: log.NewHelper(logger),
+		uc:       uc,
+		maxBatch: maxBatchSize(c),
+		log:      log.NewHelper(logger),
+		tracer:   tracer,
 	}
 }
 
-func (s *UsersService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersService.CreateUser")
-	defer span.End()
+func (s *UsersService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (_ *pb.CreateUserResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.CreateUser")
+	defer func() { monitor.EndSpan(span, err) }()
 	reqPr := &biz.User{
 		Username: req.GetUsername(),
 		Email:    req.GetEmail(),
@@ -43,9 +52,9 @@ func (s *UsersService) CreateUser(ctx context.Context, req *pb.CreateUserRequest
 	return resp, nil
 }
 
-func (s *UsersService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersService.GetUser")
-	defer span.End()
+func (s *UsersService) GetUser(ctx context.Context, req *pb.GetUserRequest) (_ *pb.GetUserResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.GetUser", biz.AttrUserID.String(req.GetId()))
+	defer func() { monitor.EndSpan(span, err) }()
 
 	res, err := s.uc.GetUser(ctx, req.GetId())
 	if err != nil {
@@ -63,9 +72,9 @@ func (s *UsersService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb
 	return resp, nil
 }
 
-func (s *UsersService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersService.ListUsers")
-	defer span.End()
+func (s *UsersService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (_ *pb.ListUsersResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.ListUsers")
+	defer func() { monitor.EndSpan(span, err) }()
 	reqPr := &biz.Pagination{
 		Page: req.GetPagination().GetPage(),
 		Size: req.GetPagination().GetPageSize(),
@@ -90,9 +99,9 @@ func (s *UsersService) ListUsers(ctx context.Context, req *pb.ListUsersRequest)
 	return resp, nil
 }
 
-func (s *UsersService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersService.UpdateUser")
-	defer span.End()
+func (s *UsersService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (_ *pb.UpdateUserResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.UpdateUser", biz.AttrUserID.String(req.GetId()))
+	defer func() { monitor.EndSpan(span, err) }()
 	reqPr := &biz.User{
 		ID:       req.GetId(),
 		Username: req.GetUsername(),
@@ -109,9 +118,9 @@ func (s *UsersService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest
 	}
 	return resp, nil
 }
-func (s *UsersService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersService.DeleteUser")
-	defer span.End()
+func (s *UsersService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (_ *pb.DeleteUserResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.DeleteUser", biz.AttrUserID.String(req.GetId()))
+	defer func() { monitor.EndSpan(span, err) }()
 	res, err := s.uc.DeleteUser(ctx, req.GetId())
 	if err != nil {
 		return nil, err
@@ -121,18 +130,21 @@ func (s *UsersService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest
 	}
 	return resp, nil
 }
-func (s *UsersService) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
-	ctx, span := otel.Tracer("users").Start(ctx, "UsersService.SearchUsers")
-	defer span.End()
+func (s *UsersService) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (_ *pb.SearchUsersResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.SearchUsers", redact.Attr(string(biz.AttrSearchQuery), req.GetQuery()))
+	defer func() { monitor.EndSpan(span, err) }()
 	reqPr := &biz.Pagination{
 		Page: req.GetPagination().GetPage(),
 		Size: req.GetPagination().GetPageSize(),
 	}
-	res, err := s.uc.SearchUsers(ctx, req.GetQuery(), reqPr)
+	if reqPr.Size <= 0 {
+		reqPr.Size = 10
+	}
+	res, total, err := s.uc.SearchUsers(ctx, req.GetQuery(), reqPr)
 	if err != nil {
 		return nil, err
 	}
-	var users []*pb.User
+	users := make([]*pb.User, 0, len(res))
 	for _, u := range res {
 		users = append(users, &pb.User{
 			Id:       u.ID,
@@ -144,6 +156,107 @@ func (s *UsersService) SearchUsers(ctx context.Context, req *pb.SearchUsersReque
 	}
 	resp := &pb.SearchUsersResponse{
 		Users: users,
+		Pagination: &pb.Pagination{
+			Page:     &reqPr.Page,
+			PageSize: &reqPr.Size,
+		},
+		Total: total,
 	}
 	return resp, nil
 }
+
+func (s *UsersService) CheckAvailability(ctx context.Context, req *pb.CheckAvailabilityRequest) (_ *pb.CheckAvailabilityResponse, err error) {
+	var (
+		field biz.UniqueField
+		value string
+	)
+	switch f := req.GetField().(type) {
+	case *pb.CheckAvailabilityRequest_Username:
+		field, value = biz.FieldUsername, f.Username
+	case *pb.CheckAvailabilityRequest_Email:
+		field, value = biz.FieldEmail, f.Email
+	case *pb.CheckAvailabilityRequest_Phone:
+		field, value = biz.FieldPhone, f.Phone
+	default:
+		return nil, pb.ErrorUserInvalidArgument("one of username, email or phone is required")
+	}
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.CheckAvailability", biz.AttrUniqueField.String(string(field)))
+	defer func() { monitor.EndSpan(span, err) }()
+	available, err := s.uc.CheckAvailability(ctx, field, value)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckAvailabilityResponse{
+		Field:     string(field),
+		Available: available,
+	}, nil
+}
+
+func (s *UsersService) BatchGetUsers(ctx context.Context, req *pb.BatchGetUsersRequest) (_ *pb.BatchGetUsersResponse, err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.BatchGetUsers", biz.AttrBatchSize.Int(len(req.GetIds())))
+	defer func() { monitor.EndSpan(span, err) }()
+	if len(req.GetIds()) > s.maxBatch {
+		return nil, pb.ErrorUserInvalidArgument("at most %d ids per batch, got %d", s.maxBatch, len(req.GetIds()))
+	}
+	items, err := s.uc.BatchGetUsers(ctx, req.GetIds(), req.GetAtomic())
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*pb.BatchGetUsersResponse_Result, 0, len(items))
+	for i, item := range items {
+		result := &pb.BatchGetUsersResponse_Result{Id: req.GetIds()[i]}
+		if item.Err != nil {
+			result.Outcome = &pb.BatchGetUsersResponse_Result_Error{Error: userBatchError(item.Err)}
+		} else {
+			result.Outcome = &pb.BatchGetUsersResponse_Result_User{User: &pb.User{
+				Id:       item.Value.ID,
+				Username: item.Value.Username,
+				Email:    item.Value.Email,
+				Phone:    item.Value.Phone,
+				Picture:  &item.Value.Picture,
+			}}
+		}
+		results = append(results, result)
+	}
+	return &pb.BatchGetUsersResponse{Results: results}, nil
+}
+
+// userBatchError converts the error of a batch item to its API form.
+func userBatchError(err error) *pb.BatchError {
+	se := errors.FromError(err)
+	return &pb.BatchError{Code: se.Code, Reason: se.Reason, Message: se.Message}
+}
+
+var userChangeTypes = map[biz.ChangeType]pb.ChangeType{
+	biz.ChangeCreated: pb.ChangeType_CREATED,
+	biz.ChangeUpdated: pb.ChangeType_UPDATED,
+	biz.ChangeDeleted: pb.ChangeType_DELETED,
+}
+
+func (s *UsersService) WatchUsers(req *pb.WatchUsersRequest, stream pb.Users_WatchUsersServer) error {
+	return s.WatchUserChanges(stream.Context(), req, stream.Send)
+}
+
+// WatchUserChanges calls fn with every change of users, see WatchUsers.
+func (s *UsersService) WatchUserChanges(ctx context.Context, req *pb.WatchUsersRequest, fn func(*pb.UserChange) error) (err error) {
+	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.WatchUsers")
+	defer func() { monitor.EndSpan(span, err) }()
+	return s.uc.WatchUsers(ctx, req.GetResumeToken(), func(c *biz.Change[*biz.User]) error {
+		change := &pb.UserChange{
+			Type:        userChangeTypes[c.Type],
+			Id:          c.ID,
+			Time:        c.Time.Format(time.RFC3339),
+			ResumeToken: c.ResumeToken,
+		}
+		if u := c.Value; u != nil {
+			change.User = &pb.User{
+				Id:       u.ID,
+				Username: u.Username,
+				Email:    u.Email,
+				Phone:    u.Phone,
+				Picture:  optionalString(u.Picture),
+			}
+		}
+		return fn(change)
+	})
+}
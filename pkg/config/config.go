@@ -0,0 +1,180 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"layout/internal/conf"
+
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+// DefaultEnvPrefix is the prefix of environment variables overriding config keys.
+const DefaultEnvPrefix = "APP"
+
+type options struct {
+	envPrefix string
+}
+
+type Option func(*options)
+
+// WithEnvPrefix sets the prefix of environment variable overrides.
+func WithEnvPrefix(prefix string) Option {
+	return func(o *options) {
+		o.envPrefix = prefix
+	}
+}
+
+// Load builds and loads the bootstrap config found at path, layered as:
+//
+//  1. base files: path itself, or every file of the directory that is not an overlay
+//  2. the overlay of the current environment, e.g. config.prod.yaml
+//  3. PREFIX_* environment variables, see NewEnvSource
+//
+// The environment is read from metadata.env once the base files and the
+// environment variables are merged, so APP_METADATA_ENV selects the overlay.
+// ${VAR} and ${VAR:default} placeholders are expanded from the process
+// environment first and from other config keys second.
+func Load(path string, opts ...Option) (kconfig.Config, error) {
+	o := options{envPrefix: DefaultEnvPrefix}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	base, overlays, err := listFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	envSource := NewEnvSource(o.envPrefix, (&conf.Bootstrap{}).ProtoReflect().Descriptor())
+
+	env, err := environment(base, envSource)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []kconfig.Source
+	for _, f := range base {
+		sources = append(sources, file.NewSource(f))
+	}
+	for _, f := range overlays[env] {
+		sources = append(sources, file.NewSource(f))
+	}
+	sources = append(sources, envSource)
+
+	c := kconfig.New(
+		kconfig.WithSource(sources...),
+		kconfig.WithResolver(resolvePlaceholders),
+	)
+	if err := c.Load(); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadBootstrap loads the config at path like Load, then scans it into a
+// bootstrap with defaults applied and validates it.
+func LoadBootstrap(path string, opts ...Option) (kconfig.Config, *conf.Bootstrap, error) {
+	c, err := Load(path, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		c.Close()
+		return nil, nil, err
+	}
+	ApplyDefaults(&bc)
+	if err := Validate(&bc); err != nil {
+		c.Close()
+		return nil, nil, err
+	}
+	return c, &bc, nil
+}
+
+// overlayRe matches environment overlays such as config.stage.yaml.
+var overlayRe = regexp.MustCompile(`^.+\.([a-z]+)\.[a-z]+$`)
+
+// listFiles splits the config files at path into base files and per
+// environment overlays keyed by the lower-cased environment name.
+func listFiles(path string) ([]string, map[string][]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		base     []string
+		overlays = map[string][]string{}
+		files    []string
+	)
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, e := range entries {
+			if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+				continue
+			}
+			files = append(files, filepath.Join(path, e.Name()))
+		}
+	} else {
+		// a single file also picks up its siblings named <name>.<env><ext>
+		ext := filepath.Ext(path)
+		matches, err := filepath.Glob(strings.TrimSuffix(path, ext) + ".*" + ext)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append([]string{path}, matches...)
+	}
+	sort.Strings(files)
+	for _, f := range files {
+		if env := overlayEnv(filepath.Base(f)); env != "" {
+			overlays[env] = append(overlays[env], f)
+			continue
+		}
+		if f == path || info.IsDir() {
+			base = append(base, f)
+		}
+	}
+	return base, overlays, nil
+}
+
+func overlayEnv(name string) string {
+	m := overlayRe.FindStringSubmatch(name)
+	if m == nil {
+		return ""
+	}
+	if v, ok := conf.AppMetadata_Environment_value[strings.ToUpper(m[1])]; ok && v != 0 {
+		return m[1]
+	}
+	return ""
+}
+
+// environment returns the lower-cased environment configured by the base files
+// and the environment variables, or "" when none is set.
+func environment(base []string, envSource kconfig.Source) (string, error) {
+	var sources []kconfig.Source
+	for _, f := range base {
+		sources = append(sources, file.NewSource(f))
+	}
+	sources = append(sources, envSource)
+	c := kconfig.New(kconfig.WithSource(sources...), kconfig.WithResolver(resolveBasePlaceholders))
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		return "", err
+	}
+	env, err := c.Value("metadata.env").String()
+	if err != nil {
+		return "", nil
+	}
+	if _, ok := conf.AppMetadata_Environment_value[strings.ToUpper(env)]; !ok {
+		return "", fmt.Errorf("metadata.env: unknown environment %q", env)
+	}
+	return strings.ToLower(env), nil
+}
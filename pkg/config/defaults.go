@@ -0,0 +1,49 @@
+package config
+
+import (
+	"layout/internal/conf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ApplyDefaults fills the optional sections of bc left out of the config files.
+// Monitoring points at an OTLP collector on localhost, the exporter's own default.
+func ApplyDefaults(bc *conf.Bootstrap) {
+	if bc.Monitoring == nil {
+		bc.Monitoring = &conf.Monitoring{}
+	}
+	if bc.Monitoring.Trace == nil {
+		bc.Monitoring.Trace = &conf.Monitoring_Trace{Endpoint: "localhost:4318", Insecure: true}
+	}
+	if bc.Monitoring.Trace.Exporter == "" {
+		bc.Monitoring.Trace.Exporter = "otlp_http"
+	}
+	if bc.Monitoring.Trace.Sampler == nil {
+		bc.Monitoring.Trace.Sampler = &conf.Monitoring_Trace_Sampler{Type: "parent_based"}
+	}
+	if bc.Monitoring.Trace.Sampler.Ratio == nil {
+		bc.Monitoring.Trace.Sampler.Ratio = proto.Float64(1)
+	}
+	if bc.Monitoring.Metrics == nil {
+		bc.Monitoring.Metrics = &conf.Monitoring_Metrics{}
+	}
+	if bc.Monitoring.Logs == nil {
+		bc.Monitoring.Logs = &conf.Monitoring_Logs{}
+	}
+	if bc.Monitoring.Logs.Exporter == "" {
+		bc.Monitoring.Logs.Exporter = "otlp_http"
+	}
+
+	if bc.Log == nil {
+		bc.Log = &conf.Log{}
+	}
+	if bc.Log.Level == "" {
+		bc.Log.Level = "info"
+	}
+	if bc.Log.Logger == "" {
+		bc.Log.Logger = "zap"
+	}
+	if bc.Log.Rotation == nil {
+		bc.Log.Rotation = &conf.Log_Rotation{MaxSize: 100, MaxAge: 30, MaxBackups: 5, Compress: true}
+	}
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ kconfig.Source = (*envSource)(nil)
+
+// envSource maps PREFIX_SECTION_FIELD environment variables onto the config
+// tree described by a proto message, e.g. APP_DATA_POSTGRES_SOURCE becomes
+// data.postgres.source. Field names containing underscores are resolved
+// against the schema, so APP_DATA_REDIS_READ_TIMEOUT maps to
+// data.redis.read_timeout. Repeated fields take comma separated values.
+type envSource struct {
+	prefix string
+	desc   protoreflect.MessageDescriptor
+}
+
+// NewEnvSource returns a source overriding fields of desc from the environment.
+func NewEnvSource(prefix string, desc protoreflect.MessageDescriptor) kconfig.Source {
+	return &envSource{prefix: strings.TrimSuffix(prefix, "_") + "_", desc: desc}
+}
+
+func (e *envSource) Load() ([]*kconfig.KeyValue, error) {
+	tree := map[string]interface{}{}
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(k, e.prefix) {
+			continue
+		}
+		parts := strings.Split(strings.ToLower(strings.TrimPrefix(k, e.prefix)), "_")
+		path, fd := lookupField(e.desc, parts)
+		if fd == nil {
+			continue
+		}
+		setPath(tree, path, envValue(fd, v))
+	}
+	if len(tree) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+	return []*kconfig.KeyValue{{Key: "env", Value: raw, Format: "json"}}, nil
+}
+
+func (e *envSource) Watch() (kconfig.Watcher, error) {
+	return env.NewWatcher()
+}
+
+// lookupField resolves the underscore separated parts against desc and
+// returns the config path along with the descriptor of the leaf field.
+func lookupField(desc protoreflect.MessageDescriptor, parts []string) ([]string, protoreflect.FieldDescriptor) {
+	for i := len(parts); i > 0; i-- {
+		name := strings.Join(parts[:i], "_")
+		fd := desc.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			continue
+		}
+		rest := parts[i:]
+		switch {
+		case len(rest) == 0:
+			if fd.IsMap() || (fd.Message() != nil && !isScalarMessage(fd.Message())) {
+				return nil, nil
+			}
+			return []string{name}, fd
+		case fd.IsMap():
+			return []string{name, strings.Join(rest, "_")}, fd.MapValue()
+		case fd.Message() != nil && !fd.IsList() && !isScalarMessage(fd.Message()):
+			if path, leaf := lookupField(fd.Message(), rest); leaf != nil {
+				return append([]string{name}, path...), leaf
+			}
+		}
+	}
+	return nil, nil
+}
+
+// isScalarMessage reports well known types that are written as plain values.
+func isScalarMessage(md protoreflect.MessageDescriptor) bool {
+	switch md.FullName() {
+	case "google.protobuf.Duration", "google.protobuf.Timestamp":
+		return true
+	}
+	return strings.HasPrefix(string(md.FullName()), "google.protobuf.") && strings.HasSuffix(string(md.Name()), "Value")
+}
+
+func envValue(fd protoreflect.FieldDescriptor, v string) interface{} {
+	if fd.IsList() {
+		items := strings.Split(v, ",")
+		res := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			if item = strings.TrimSpace(item); item != "" {
+				res = append(res, scalarValue(fd, item))
+			}
+		}
+		return res
+	}
+	return scalarValue(fd, v)
+}
+
+func scalarValue(fd protoreflect.FieldDescriptor, v string) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	case protoreflect.EnumKind:
+		return strings.ToUpper(v)
+	}
+	// protojson accepts integers, durations and strings in their string form
+	return v
+}
+
+func setPath(tree map[string]interface{}, path []string, v interface{}) {
+	for _, p := range path[:len(path)-1] {
+		next, ok := tree[p].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			tree[p] = next
+		}
+		tree = next
+	}
+	tree[path[len(path)-1]] = v
+}
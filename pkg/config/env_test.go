@@ -0,0 +1,85 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"layout/internal/conf"
+)
+
+func TestEnvSource(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want map[string]interface{}
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "nested field",
+			env:  map[string]string{"TEST_DATA_POSTGRES_SOURCE": "postgres://db/users"},
+			want: map[string]interface{}{"data": map[string]interface{}{"postgres": map[string]interface{}{"source": "postgres://db/users"}}},
+		},
+		{
+			name: "field name with underscores",
+			env:  map[string]string{"TEST_DATA_REDIS_READ_TIMEOUT": "2s"},
+			want: map[string]interface{}{"data": map[string]interface{}{"redis": map[string]interface{}{"read_timeout": "2s"}}},
+		},
+		{
+			name: "typed values",
+			env: map[string]string{
+				"TEST_LOG_SAMPLING_ENABLED":           "true",
+				"TEST_LOG_SAMPLING_INITIAL":           "20",
+				"TEST_MONITORING_TRACE_SAMPLER_RATIO": "0.25",
+				"TEST_METADATA_ENV":                   "prod",
+			},
+			want: map[string]interface{}{
+				"log":        map[string]interface{}{"sampling": map[string]interface{}{"enabled": true, "initial": "20"}},
+				"monitoring": map[string]interface{}{"trace": map[string]interface{}{"sampler": map[string]interface{}{"ratio": 0.25}}},
+				"metadata":   map[string]interface{}{"env": "PROD"},
+			},
+		},
+		{
+			name: "repeated field",
+			env:  map[string]string{"TEST_SERVER_HTTP_CORS_ALLOW_ORIGINS": "https://a.com, https://b.com,"},
+			want: map[string]interface{}{"server": map[string]interface{}{"http": map[string]interface{}{"cors": map[string]interface{}{
+				"allow_origins": []interface{}{"https://a.com", "https://b.com"},
+			}}}},
+		},
+		{
+			name: "map entry",
+			env:  map[string]string{"TEST_MONITORING_TRACE_HEADERS_API_KEY": "secret"},
+			want: map[string]interface{}{"monitoring": map[string]interface{}{"trace": map[string]interface{}{"headers": map[string]interface{}{"api_key": "secret"}}}},
+		},
+		{
+			name: "unknown fields and whole messages",
+			env: map[string]string{
+				"TEST_SERVER_UNKNOWN": "x",
+				"TEST_DATA_REDIS":     "x",
+				"OTHER_LOG_LEVEL":     "warn",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			kvs, err := NewEnvSource("TEST", (&conf.Bootstrap{}).ProtoReflect().Descriptor()).Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]interface{}
+			if len(kvs) > 0 {
+				if err := json.Unmarshal(kvs[0].Value, &got); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// placeholderRe matches ${NAME} and ${NAME:default}.
+var placeholderRe = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// resolvePlaceholders expands placeholders in every string of the merged config.
+// NAME is looked up in the process environment, then as a dotted config key;
+// a placeholder without default whose NAME is found in neither is an error.
+func resolvePlaceholders(input map[string]interface{}) error {
+	return resolve(input, true)
+}
+
+// resolveBasePlaceholders expands placeholders like resolvePlaceholders but
+// leaves the unset ones empty, for the base files alone whose overlay may
+// still replace them.
+func resolveBasePlaceholders(input map[string]interface{}) error {
+	return resolve(input, false)
+}
+
+func resolve(input map[string]interface{}, strict bool) error {
+	unset := map[string]bool{}
+	var walk func(v interface{}) interface{}
+	walk = func(v interface{}) interface{} {
+		switch t := v.(type) {
+		case string:
+			return expand(input, t, unset)
+		case map[string]interface{}:
+			for k, sub := range t {
+				t[k] = walk(sub)
+			}
+		case []interface{}:
+			for i, sub := range t {
+				t[i] = walk(sub)
+			}
+		}
+		return v
+	}
+	walk(input)
+	if !strict || len(unset) == 0 {
+		return nil
+	}
+	names := slices.Sorted(maps.Keys(unset))
+	errs := make([]error, 0, len(names))
+	for _, name := range names {
+		errs = append(errs, fmt.Errorf("${%s} is not set and has no default", name))
+	}
+	return errors.Join(errs...)
+}
+
+// expand replaces the placeholders of s, adding the names of the ones without
+// value nor default to unset.
+func expand(input map[string]interface{}, s string, unset map[string]bool) string {
+	return placeholderRe.ReplaceAllStringFunc(s, func(m string) string {
+		name, def, hasDef := strings.Cut(strings.TrimSpace(m[2:len(m)-1]), ":")
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		if v, ok := lookup(input, name); ok {
+			return v
+		}
+		if !hasDef {
+			unset[name] = true
+		}
+		return def
+	})
+}
+
+func lookup(input map[string]interface{}, key string) (string, bool) {
+	var cur interface{} = input
+	for _, p := range strings.Split(key, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if cur, ok = m[p]; !ok {
+			return "", false
+		}
+	}
+	switch cur.(type) {
+	case map[string]interface{}, []interface{}, nil:
+		return "", false
+	}
+	return fmt.Sprint(cur), true
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	input := map[string]interface{}{
+		"data": map[string]interface{}{
+			"postgres": map[string]interface{}{"source": "postgres://db/users", "port": 5432},
+			"hosts":    []interface{}{"a", "b"},
+			"empty":    nil,
+		},
+	}
+	tests := []struct {
+		key  string
+		want string
+		ok   bool
+	}{
+		{"data.postgres.source", "postgres://db/users", true},
+		{"data.postgres.port", "5432", true},
+		{"data.postgres", "", false},
+		{"data.hosts", "", false},
+		{"data.empty", "", false},
+		{"data.postgres.missing", "", false},
+		{"data.postgres.source.deeper", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := lookup(input, tt.key)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("lookup(%q) = %q, %v, want %q, %v", tt.key, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	t.Setenv("TEST_PASSWORD", "secret")
+	t.Setenv("TEST_EMPTY", "")
+	input := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "server"},
+	}
+	tests := []struct {
+		s     string
+		want  string
+		unset []string
+	}{
+		{"plain", "plain", nil},
+		{"${TEST_PASSWORD}", "secret", nil},
+		{"${ TEST_PASSWORD }", "secret", nil},
+		{"pg:${TEST_PASSWORD}@${TEST_HOST:localhost}", "pg:secret@localhost", nil},
+		{"${TEST_EMPTY:fallback}", "", nil},
+		{"${metadata.name}-1", "server-1", nil},
+		{"${TEST_TOKEN:}", "", nil},
+		{"${TEST_TOKEN}", "", []string{"TEST_TOKEN"}},
+	}
+	for _, tt := range tests {
+		unset := map[string]bool{}
+		got := expand(input, tt.s, unset)
+		var gotUnset []string
+		for name := range unset {
+			gotUnset = append(gotUnset, name)
+		}
+		if got != tt.want || !reflect.DeepEqual(gotUnset, tt.unset) {
+			t.Errorf("expand(%q) = %q, unset %v, want %q, unset %v", tt.s, got, gotUnset, tt.want, tt.unset)
+		}
+	}
+}
+
+func TestResolvePlaceholders(t *testing.T) {
+	t.Setenv("TEST_PASSWORD", "secret")
+	tests := []struct {
+		name    string
+		input   map[string]interface{}
+		want    map[string]interface{}
+		wantErr []string
+	}{
+		{
+			name: "nested values",
+			input: map[string]interface{}{
+				"data": map[string]interface{}{
+					"password": "${TEST_PASSWORD}",
+					"hosts":    []interface{}{"${TEST_HOST:localhost}", 1},
+				},
+			},
+			want: map[string]interface{}{
+				"data": map[string]interface{}{
+					"password": "secret",
+					"hosts":    []interface{}{"localhost", 1},
+				},
+			},
+		},
+		{
+			name: "unset without default",
+			input: map[string]interface{}{
+				"data": map[string]interface{}{
+					"mongo": "${TEST_MONGO_PASSWORD}",
+					"nats":  []interface{}{"${TEST_NATS_PASSWORD}"},
+				},
+			},
+			wantErr: []string{"${TEST_MONGO_PASSWORD}", "${TEST_NATS_PASSWORD}"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resolvePlaceholders(tt.input)
+			if len(tt.wantErr) > 0 {
+				if err == nil {
+					t.Fatal("resolvePlaceholders() succeeded, want an error")
+				}
+				for _, name := range tt.wantErr {
+					if !strings.Contains(err.Error(), name) {
+						t.Errorf("resolvePlaceholders() = %q, want it to name %s", err, name)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.input, tt.want) {
+				t.Errorf("resolvePlaceholders() = %v, want %v", tt.input, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveBasePlaceholders(t *testing.T) {
+	input := map[string]interface{}{"password": "${TEST_MONGO_PASSWORD}"}
+	if err := resolveBasePlaceholders(input); err != nil {
+		t.Fatal(err)
+	}
+	if input["password"] != "" {
+		t.Errorf("password = %q, want it empty", input["password"])
+	}
+}
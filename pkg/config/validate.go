@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"layout/pkg/validation"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Violation is a single failed rule of the config schema.
+type Violation struct {
+	// Path is the config path of the field, e.g. data.postgres.source.
+	Path   string
+	Reason string
+}
+
+func (v Violation) String() string {
+	return v.Path + ": " + v.Reason
+}
+
+// ValidationError lists every violation found in a config.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, 0, len(e.Violations)+1)
+	lines = append(lines, fmt.Sprintf("invalid config, %d violation(s):", len(e.Violations)))
+	for _, v := range e.Violations {
+		lines = append(lines, "  "+v.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Validate checks m against the rules declared in its proto definition and
+// returns a *ValidationError naming the config path of every failed rule.
+func Validate(m proto.Message) error {
+	found := validation.Violations(m)
+	if len(found) == 0 {
+		return nil
+	}
+	violations := make([]Violation, 0, len(found))
+	for _, v := range found {
+		violations = append(violations, Violation{Path: v.Field, Reason: v.Description})
+	}
+	return &ValidationError{Violations: violations}
+}
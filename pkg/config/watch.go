@@ -0,0 +1,168 @@
+package config
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"layout/internal/conf"
+
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// reloadable are the config paths applied without a restart, any other change
+// is logged as requiring one.
+var reloadable = []string{
+	"log.level",
+	"server.http.cors.allow_origins",
+}
+
+type subscription struct {
+	path string
+	fn   func(*conf.Bootstrap)
+}
+
+// Watcher reloads the bootstrap config when its sources change and notifies
+// the subscribers of the paths that changed. A nil *Watcher is valid: it
+// never reloads and subscriptions are no-ops, which suits one-off commands.
+type Watcher struct {
+	path    string
+	opts    []Option
+	c       kconfig.Config
+	current atomic.Pointer[conf.Bootstrap]
+	log     *log.Helper
+
+	mu   sync.Mutex
+	subs []subscription
+}
+
+// NewWatcher returns a watcher of c, loaded from path with opts and whose
+// scanned value is bc.
+func NewWatcher(path string, c kconfig.Config, bc *conf.Bootstrap, logger log.Logger, opts ...Option) *Watcher {
+	w := &Watcher{path: path, opts: opts, c: c, log: log.NewHelper(logger)}
+	w.current.Store(bc)
+	return w
+}
+
+// Watch starts watching the top level sections of the config.
+func (w *Watcher) Watch() error {
+	if w == nil {
+		return nil
+	}
+	fields := (&conf.Bootstrap{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		key := string(fields.Get(i).Name())
+		if err := w.c.Watch(key, func(string, kconfig.Value) { w.reload() }); err != nil {
+			// sections missing at startup are not watched
+			if err == kconfig.ErrNotFound {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// OnLogLevel subscribes fn to log.level.
+func (w *Watcher) OnLogLevel(fn func(level string)) {
+	w.subscribe("log.level", func(bc *conf.Bootstrap) { fn(bc.GetLog().GetLevel()) })
+}
+
+// OnCORSOrigins subscribes fn to server.http.cors.allow_origins.
+func (w *Watcher) OnCORSOrigins(fn func(origins []string)) {
+	w.subscribe("server.http.cors.allow_origins", func(bc *conf.Bootstrap) {
+		fn(bc.GetServer().GetHttp().GetCors().GetAllowOrigins())
+	})
+}
+
+func (w *Watcher) subscribe(path string, fn func(*conf.Bootstrap)) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subs = append(w.subs, subscription{path: path, fn: fn})
+}
+
+// reload loads the config again and applies it when valid. The layers are
+// rebuilt from scratch since kratos merges a changed file over the others,
+// overlays included. Several sections may change at once, each firing its
+// own watch; only the first sees a diff.
+func (w *Watcher) reload() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	c, next, err := LoadBootstrap(w.path, w.opts...)
+	if err != nil {
+		w.log.Errorf("CONFIG: failed to reload, keeping the current config: %s", err)
+		return
+	}
+	c.Close()
+
+	prev := w.current.Load()
+	changed := diff(prev.ProtoReflect(), next.ProtoReflect(), "")
+	if len(changed) == 0 {
+		return
+	}
+
+	var applied, restart []string
+	for _, path := range changed {
+		if isReloadable(path) {
+			applied = append(applied, path)
+		} else {
+			restart = append(restart, path)
+		}
+	}
+	w.current.Store(next)
+	w.log.Infof("CONFIG: reloaded, applied: %v, restart required: %v", applied, restart)
+
+	for _, s := range w.subs {
+		for _, path := range applied {
+			if underPath(path, s.path) {
+				s.fn(next)
+				break
+			}
+		}
+	}
+}
+
+func isReloadable(path string) bool {
+	for _, p := range reloadable {
+		if underPath(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// underPath reports whether path is prefix or nested below it.
+func underPath(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+".")
+}
+
+// diff returns the paths of the leaf fields that differ between a and b.
+// Lists, maps and well known types are compared as a whole.
+func diff(a, b protoreflect.Message, prefix string) []string {
+	var paths []string
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := string(fd.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		if !a.Has(fd) && !b.Has(fd) {
+			continue
+		}
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() && !isScalarMessage(fd.Message()) {
+			paths = append(paths, diff(a.Get(fd).Message(), b.Get(fd).Message(), path)...)
+			continue
+		}
+		if !a.Get(fd).Equal(b.Get(fd)) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
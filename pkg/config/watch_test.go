@@ -0,0 +1,160 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDiff(t *testing.T) {
+	base := func() *conf.Bootstrap {
+		return &conf.Bootstrap{
+			Server: &conf.Server{
+				Http: &conf.Server_HTTP{Addr: ":8000", Timeout: durationpb.New(1e9), Cors: &conf.Server_HTTP_CORS{AllowOrigins: []string{"*"}}},
+				Grpc: &conf.Server_GRPC{Addr: ":9000"},
+			},
+			Log: &conf.Log{Level: "info"},
+		}
+	}
+	tests := []struct {
+		name   string
+		change func(*conf.Bootstrap)
+		want   []string
+	}{
+		{
+			name:   "unchanged",
+			change: func(*conf.Bootstrap) {},
+		},
+		{
+			name:   "leaf fields",
+			change: func(bc *conf.Bootstrap) { bc.Log.Level = "debug"; bc.Server.Grpc.Addr = ":9001" },
+			want:   []string{"server.grpc.addr", "log.level"},
+		},
+		{
+			name: "list as a whole",
+			change: func(bc *conf.Bootstrap) {
+				bc.Server.Http.Cors.AllowOrigins = append(bc.Server.Http.Cors.AllowOrigins, "https://a.com")
+			},
+			want: []string{"server.http.cors.allow_origins"},
+		},
+		{
+			name:   "duration as a value",
+			change: func(bc *conf.Bootstrap) { bc.Server.Http.Timeout = durationpb.New(2e9) },
+			want:   []string{"server.http.timeout"},
+		},
+		{
+			name:   "message added",
+			change: func(bc *conf.Bootstrap) { bc.Server.Admin = &conf.Server_Admin{Token: "secret"} },
+			want:   []string{"server.admin.token"},
+		},
+		{
+			name:   "message removed",
+			change: func(bc *conf.Bootstrap) { bc.Log = nil },
+			want:   []string{"log.level"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := base()
+			tt.change(next)
+			got := diff(base().ProtoReflect(), next.ProtoReflect(), "")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReloadable(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"log.level", true},
+		{"server.http.cors.allow_origins", true},
+		{"log.levels", false},
+		{"log", false},
+		{"server.http.cors.allow_methods", false},
+		{"server.http.addr", false},
+	}
+	for _, tt := range tests {
+		if got := isReloadable(tt.path); got != tt.want {
+			t.Errorf("isReloadable(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+const watchedConfig = `
+server:
+  http:
+    addr: 0.0.0.0:8000
+    cors:
+      allow_origins: ["*"]
+  grpc:
+    addr: 0.0.0.0:9000
+data:
+  postgres:
+    driver: pgx
+    source: postgres://localhost/users
+  redis:
+    addr: localhost:6379
+  mongo:
+    uri: mongodb://localhost:27017
+    database: products
+  nats:
+    addr: nats://localhost:4222
+metadata:
+  name: server
+  env: DEV
+log:
+  level: %s
+`
+
+func TestWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	opts := []Option{WithEnvPrefix("TEST_WATCH")}
+	write(fmt.Sprintf(watchedConfig, "info"))
+	c, bc, err := LoadBootstrap(path, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+
+	w := NewWatcher(path, nil, bc, log.NewStdLogger(io.Discard), opts...)
+	var levels []string
+	var origins int
+	w.OnLogLevel(func(level string) { levels = append(levels, level) })
+	w.OnCORSOrigins(func([]string) { origins++ })
+
+	write(fmt.Sprintf(watchedConfig, "debug"))
+	w.reload()
+	if !reflect.DeepEqual(levels, []string{"debug"}) || origins != 0 {
+		t.Fatalf("after a level change, levels = %v and %d origin updates, want [debug] and none", levels, origins)
+	}
+
+	// a second watch firing for the same change sees no diff
+	w.reload()
+	if len(levels) != 1 {
+		t.Errorf("levels = %v after an unchanged reload, want a single update", levels)
+	}
+
+	write("server: [")
+	w.reload()
+	if got := w.current.Load().GetLog().GetLevel(); got != "debug" || len(levels) != 1 {
+		t.Errorf("after an invalid reload, level = %q and %d updates, want the config kept", got, len(levels))
+	}
+}
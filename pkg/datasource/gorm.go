@@ -8,7 +8,6 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	_ "github.com/jackc/pgx/v4/stdlib"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -74,6 +73,14 @@ func NewGorm(c *conf.Data, logger log.Logger, tp trace.TracerProvider) (Gorm, er
 	}
 
 	cleanup := func() {
+		lg.Info("GORM: closing the database pool")
+		sqlDB, err := db.DB()
+		if err == nil {
+			err = sqlDB.Close()
+		}
+		if err != nil {
+			lg.Error("Error closing the database pool: ", err)
+		}
 	}
 
 	return &gormStruct{
@@ -82,25 +89,3 @@ func NewGorm(c *conf.Data, logger log.Logger, tp trace.TracerProvider) (Gorm, er
 		cleanup: cleanup,
 	}, nil
 }
-
-func GormMigrate(ctx context.Context, c *conf.Data, logger log.Logger, models ...interface{}) {
-	l := log.NewHelper(logger)
-	client, err := openDB(ctx, c, otel.GetTracerProvider())
-	if err != nil {
-		l.Errorf("failed opening database: %s", err)
-	}
-	for _, model := range models {
-		migrator := client.Migrator()
-		err = migrator.AutoMigrate(model)
-		if err != nil {
-			l.Errorf("failed migrating the schema: %s", err)
-			continue
-		} else {
-			l.Infof("migrated the schema successfully")
-		}
-		break
-	}
-	if err != nil {
-		l.Errorf("failed migrating the schema: %s", err)
-	}
-}
@@ -0,0 +1,81 @@
+package datasource
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []Migration
+		wantErr string
+	}{
+		{
+			name: "empty",
+			want: []Migration{},
+		},
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"10_add_index.up.sql":        {Data: []byte("up 10")},
+				"10_add_index.down.sql":      {Data: []byte("down 10")},
+				"0002_add_phone.up.sql":      {Data: []byte("up 2")},
+				"0001_create_users.up.sql":   {Data: []byte("up 1")},
+				"0001_create_users.down.sql": {Data: []byte("down 1")},
+			},
+			want: []Migration{
+				{Version: 1, Name: "create_users", Up: "up 1", Down: "down 1"},
+				{Version: 2, Name: "add_phone", Up: "up 2"},
+				{Version: 10, Name: "add_index", Up: "up 10", Down: "down 10"},
+			},
+		},
+		{
+			name: "other files ignored",
+			files: fstest.MapFS{
+				"0001_create_users.up.sql": {Data: []byte("up 1")},
+				"README.md":                {Data: []byte("notes")},
+				"0002_seed.sql":            {Data: []byte("seed")},
+				"old/0003_moved.up.sql":    {Data: []byte("up 3")},
+			},
+			want: []Migration{
+				{Version: 1, Name: "create_users", Up: "up 1"},
+			},
+		},
+		{
+			name: "down without up",
+			files: fstest.MapFS{
+				"0001_create_users.down.sql": {Data: []byte("down 1")},
+			},
+			wantErr: "missing its up script",
+		},
+		{
+			name: "conflicting names",
+			files: fstest.MapFS{
+				"0001_create_users.up.sql":    {Data: []byte("up 1")},
+				"0001_create_people.down.sql": {Data: []byte("down 1")},
+			},
+			wantErr: "conflicting names",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadMigrations(tt.files)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadMigrations() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("loadMigrations() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
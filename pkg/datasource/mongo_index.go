@@ -0,0 +1,211 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoIndex declares an index a collection is expected to have.
+type MongoIndex struct {
+	Name string
+	Keys bson.D
+	// Weights only applies to text indexes.
+	Weights       bson.D
+	Unique        bool
+	PartialFilter bson.D
+}
+
+func (i MongoIndex) isText() bool {
+	for _, k := range i.Keys {
+		if k.Value == "text" {
+			return true
+		}
+	}
+	return false
+}
+
+func (i MongoIndex) model() mongo.IndexModel {
+	opts := options.Index().SetName(i.Name)
+	if i.Unique {
+		opts.SetUnique(true)
+	}
+	if len(i.Weights) > 0 {
+		opts.SetWeights(i.Weights)
+	}
+	if len(i.PartialFilter) > 0 {
+		opts.SetPartialFilterExpression(i.PartialFilter)
+	}
+	return mongo.IndexModel{Keys: i.Keys, Options: opts}
+}
+
+// EnsureMongoIndexes creates the declared indexes missing from coll. Indexes that
+// exist under the same name but with a different definition are reported as drift
+// and left untouched, since rebuilding an index is an operational decision.
+func EnsureMongoIndexes(ctx context.Context, coll *mongo.Collection, logger log.Logger, indexes []MongoIndex) error {
+	lg := log.NewHelper(logger)
+
+	cur, err := coll.Indexes().List(ctx)
+	if err != nil {
+		return err
+	}
+	// decode into bson.D so compound keys keep their order
+	var existing []bson.D
+	if err := cur.All(ctx, &existing); err != nil {
+		return err
+	}
+	byName := make(map[string]map[string]interface{}, len(existing))
+	for _, idx := range existing {
+		spec := docOf(idx)
+		name, _ := spec["name"].(string)
+		byName[name] = spec
+	}
+
+	declared := make(map[string]bool, len(indexes))
+	var missing []mongo.IndexModel
+	for _, want := range indexes {
+		declared[want.Name] = true
+		got, ok := byName[want.Name]
+		if !ok {
+			missing = append(missing, want.model())
+			continue
+		}
+		if diff := indexDrift(want, got); diff != "" {
+			lg.Warnf("MONGO: index %s.%s drifted from its declaration: %s", coll.Name(), want.Name, diff)
+		}
+	}
+	for name := range byName {
+		if name != "_id_" && !declared[name] {
+			lg.Warnf("MONGO: index %s.%s exists but is not declared", coll.Name(), name)
+		}
+	}
+
+	if len(missing) == 0 {
+		lg.Debugf("MONGO: indexes of %s are up to date", coll.Name())
+		return nil
+	}
+	names, err := coll.Indexes().CreateMany(ctx, missing)
+	if err != nil {
+		return err
+	}
+	lg.Infof("MONGO: created indexes %v on %s", names, coll.Name())
+	return nil
+}
+
+// indexDrift describes how an existing index differs from want, or returns "".
+func indexDrift(want MongoIndex, got map[string]interface{}) string {
+	if want.isText() {
+		// text indexes are stored as {_fts: "text", _ftsx: 1}, the indexed
+		// fields and their priority live in the weights document.
+		if !sameDoc(weightsOf(want), asDoc(got["weights"])) {
+			return fmt.Sprintf("weights %v, want %v", got["weights"], weightsOf(want))
+		}
+	} else if keys, _ := got["key"].(bson.D); !sameKeys(want.Keys, keys) {
+		return fmt.Sprintf("keys %v, want %v", got["key"], want.Keys)
+	}
+	unique, _ := got["unique"].(bool)
+	if unique != want.Unique {
+		return fmt.Sprintf("unique=%t, want %t", unique, want.Unique)
+	}
+	if !sameDoc(docOf(want.PartialFilter), asDoc(got["partialFilterExpression"])) {
+		return fmt.Sprintf("partial filter %v, want %v", got["partialFilterExpression"], want.PartialFilter)
+	}
+	return ""
+}
+
+func weightsOf(i MongoIndex) map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, k := range i.Keys {
+		if k.Value == "text" {
+			res[k.Key] = int64(1)
+		}
+	}
+	for _, w := range i.Weights {
+		res[w.Key] = w.Value
+	}
+	return res
+}
+
+func docOf(d bson.D) map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, e := range d {
+		res[e.Key] = e.Value
+	}
+	return res
+}
+
+func asDoc(v interface{}) map[string]interface{} {
+	res := map[string]interface{}{}
+	switch t := v.(type) {
+	case bson.M:
+		for k, v := range t {
+			res[k] = v
+		}
+	case bson.D:
+		for _, e := range t {
+			res[e.Key] = e.Value
+		}
+	}
+	return res
+}
+
+func sameKeys(want, got bson.D) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i, e := range want {
+		if e.Key != got[i].Key || !sameValue(e.Value, got[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameDoc(want, got map[string]interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for k, v := range want {
+		if !sameValue(v, got[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameValue compares values loosely, since the server may hand back numbers
+// with a different width than the declaration used.
+func sameValue(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	if da, ok := a.(bson.D); ok {
+		return sameDoc(docOf(da), asDoc(b))
+	}
+	if ma, ok := a.(bson.M); ok {
+		return sameDoc(asDoc(ma), asDoc(b))
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
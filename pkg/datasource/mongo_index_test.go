@@ -0,0 +1,107 @@
+package datasource
+
+import (
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestIndexDrift(t *testing.T) {
+	compound := MongoIndex{Name: "category_price", Keys: bson.D{{Key: "category", Value: 1}, {Key: "price", Value: -1}}}
+	text := MongoIndex{Name: "search", Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}}}
+	weighted := text
+	weighted.Weights = bson.D{{Key: "name", Value: 10}}
+	sku := MongoIndex{
+		Name:          "sku",
+		Keys:          bson.D{{Key: "sku", Value: 1}},
+		Unique:        true,
+		PartialFilter: bson.D{{Key: "sku", Value: bson.D{{Key: "$type", Value: "string"}}}},
+	}
+	textKey := bson.D{{Key: "_fts", Value: "text"}, {Key: "_ftsx", Value: int32(1)}}
+	tests := []struct {
+		name string
+		want MongoIndex
+		got  bson.D
+		// drift is a substring of the reported drift, "" when none
+		drift string
+	}{
+		{
+			name: "same keys with other number widths",
+			want: compound,
+			got:  bson.D{{Key: "name", Value: "category_price"}, {Key: "key", Value: bson.D{{Key: "category", Value: int32(1)}, {Key: "price", Value: float64(-1)}}}},
+		},
+		{
+			name:  "key order",
+			want:  compound,
+			got:   bson.D{{Key: "name", Value: "category_price"}, {Key: "key", Value: bson.D{{Key: "price", Value: int32(-1)}, {Key: "category", Value: int32(1)}}}},
+			drift: "keys",
+		},
+		{
+			name:  "key direction",
+			want:  compound,
+			got:   bson.D{{Key: "name", Value: "category_price"}, {Key: "key", Value: bson.D{{Key: "category", Value: int32(1)}, {Key: "price", Value: int32(1)}}}},
+			drift: "keys",
+		},
+		{
+			name:  "unique",
+			want:  compound,
+			got:   bson.D{{Key: "name", Value: "category_price"}, {Key: "key", Value: bson.D{{Key: "category", Value: int32(1)}, {Key: "price", Value: int32(-1)}}}, {Key: "unique", Value: true}},
+			drift: "unique",
+		},
+		{
+			name: "text index with default weights",
+			want: text,
+			got:  bson.D{{Key: "name", Value: "search"}, {Key: "key", Value: textKey}, {Key: "weights", Value: bson.D{{Key: "description", Value: int32(1)}, {Key: "name", Value: int32(1)}}}},
+		},
+		{
+			name: "text index with declared weights",
+			want: weighted,
+			got:  bson.D{{Key: "name", Value: "search"}, {Key: "key", Value: textKey}, {Key: "weights", Value: bson.D{{Key: "description", Value: int32(1)}, {Key: "name", Value: int32(10)}}}},
+		},
+		{
+			name:  "text index weights changed",
+			want:  weighted,
+			got:   bson.D{{Key: "name", Value: "search"}, {Key: "key", Value: textKey}, {Key: "weights", Value: bson.D{{Key: "description", Value: int32(1)}, {Key: "name", Value: int32(1)}}}},
+			drift: "weights",
+		},
+		{
+			name:  "text index field added",
+			want:  text,
+			got:   bson.D{{Key: "name", Value: "search"}, {Key: "key", Value: textKey}, {Key: "weights", Value: bson.D{{Key: "name", Value: int32(1)}}}},
+			drift: "weights",
+		},
+		{
+			name: "nil and empty partial filters",
+			want: compound,
+			got:  bson.D{{Key: "name", Value: "category_price"}, {Key: "key", Value: bson.D{{Key: "category", Value: int32(1)}, {Key: "price", Value: int32(-1)}}}, {Key: "partialFilterExpression", Value: bson.D{}}},
+		},
+		{
+			name: "same partial filter",
+			want: sku,
+			got: bson.D{{Key: "name", Value: "sku"}, {Key: "key", Value: bson.D{{Key: "sku", Value: int32(1)}}}, {Key: "unique", Value: true},
+				{Key: "partialFilterExpression", Value: bson.D{{Key: "sku", Value: bson.D{{Key: "$type", Value: "string"}}}}}},
+		},
+		{
+			name:  "partial filter missing",
+			want:  sku,
+			got:   bson.D{{Key: "name", Value: "sku"}, {Key: "key", Value: bson.D{{Key: "sku", Value: int32(1)}}}, {Key: "unique", Value: true}},
+			drift: "partial filter",
+		},
+		{
+			name: "partial filter changed",
+			want: sku,
+			got: bson.D{{Key: "name", Value: "sku"}, {Key: "key", Value: bson.D{{Key: "sku", Value: int32(1)}}}, {Key: "unique", Value: true},
+				{Key: "partialFilterExpression", Value: bson.D{{Key: "sku", Value: bson.D{{Key: "$exists", Value: true}}}}}},
+			drift: "partial filter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := indexDrift(tt.want, docOf(tt.got))
+			if tt.drift == "" && got != "" || tt.drift != "" && !strings.Contains(got, tt.drift) {
+				t.Errorf("indexDrift() = %q, want %q", got, tt.drift)
+			}
+		})
+	}
+}
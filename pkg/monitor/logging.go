@@ -1,19 +1,83 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
 	"layout/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
-func NewLogger(c *conf.Bootstrap) log.Logger {
-	level := zap.NewAtomicLevelAt(zap.DebugLevel)
-	return NewZapLogger(zapcore.EncoderConfig{
+// level is shared by the loggers built by NewLogger so SetLevel applies live.
+var level = zap.NewAtomicLevelAt(zap.DebugLevel)
+
+// SetLevel changes the level of the loggers built by NewLogger.
+func SetLevel(l string) error {
+	return level.UnmarshalText([]byte(l))
+}
+
+// LevelHandler serves the level of the loggers built by NewLogger: GET returns
+// {"level":"info"} and PUT with the same body changes it.
+func LevelHandler() http.Handler {
+	return level
+}
+
+// NewLogger builds the zap or logrus logger described by c.Log. DEV logs
+// colored text to stdout, other environments log JSON lines. When filepath is
+// set the same entries are also written as JSON to a file rotated after
+// c.Log.Rotation. With c.Monitoring.Logs enabled, zap entries are shipped
+// over OTLP too. The cleanup flushes them.
+func NewLogger(ctx context.Context, c *conf.Bootstrap) (log.Logger, func(), error) {
+	if l := c.GetLog().GetLevel(); l != "" {
+		_ = SetLevel(l)
+	}
+	if c.GetLog().GetLogger() == "logrus" {
+		return newLogrus(c), func() {}, nil
+	}
+
+	encoder := zapcore.NewJSONEncoder(encoderConfig(zapcore.CapitalLevelEncoder))
+	if c.GetMetadata().GetEnv() == conf.AppMetadata_DEV {
+		encoder = zapcore.NewConsoleEncoder(encoderConfig(zapcore.CapitalColorLevelEncoder))
+	}
+	cores := []zapcore.Core{
+		zapcore.NewCore(encoder, zapcore.Lock(os.Stdout), level),
+	}
+	if path := c.GetLog().GetFilepath(); path != "" {
+		cores = append(cores, zapcore.NewCore(
+			zapcore.NewJSONEncoder(encoderConfig(zapcore.CapitalLevelEncoder)),
+			zapcore.AddSync(newRotatingFile(path, c.GetLog().GetRotation())),
+			level,
+		))
+	}
+	cleanup := func() {}
+	if c.GetMonitoring().GetLogs().GetEnabled() {
+		lp, lpCleanup, err := newLoggerProvider(ctx, c)
+		if err != nil {
+			return nil, nil, err
+		}
+		cores = append(cores, newOTelCore(c.GetMetadata().GetName(), lp, level))
+		cleanup = lpCleanup
+	}
+	core := zapcore.NewTee(cores...)
+	if s := c.GetLog().GetSampling(); s.GetEnabled() {
+		tick := s.GetTick().AsDuration()
+		if tick <= 0 {
+			tick = time.Second
+		}
+		core = zapcore.NewSamplerWithOptions(core, tick, int(s.GetInitial()), int(s.GetThereafter()))
+	}
+	return NewZapLogger(core), cleanup, nil
+}
+
+func encoderConfig(encodeLevel zapcore.LevelEncoder) zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
 		MessageKey:     "msg",
 		LevelKey:       "level",
 		TimeKey:        "ts",
@@ -21,13 +85,25 @@ func NewLogger(c *conf.Bootstrap) log.Logger {
 		CallerKey:      "caller",
 		FunctionKey:    "fn",
 		StacktraceKey:  "stack",
-		EncodeLevel:    zapcore.CapitalColorLevelEncoder,
+		EncodeLevel:    encodeLevel,
 		EncodeTime:     zapcore.ISO8601TimeEncoder,
 		EncodeDuration: zapcore.StringDurationEncoder,
 		EncodeCaller:   zapcore.ShortCallerEncoder,
 		EncodeName:     zapcore.FullNameEncoder,
 		LineEnding:     zapcore.DefaultLineEnding,
-	}, level)
+	}
+}
+
+// newRotatingFile returns a writer of path rolled over by size and pruned by
+// age and count, rotated files being gzipped when compress is set.
+func newRotatingFile(path string, r *conf.Log_Rotation) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    int(r.GetMaxSize()),
+		MaxAge:     int(r.GetMaxAge()),
+		MaxBackups: int(r.GetMaxBackups()),
+		Compress:   r.GetCompress(),
+	}
 }
 
 var _ log.Logger = (*ZapLogger)(nil)
@@ -38,13 +114,8 @@ type ZapLogger struct {
 	Sync func() error
 }
 
-// NewZapLogger return a zap logger.
-func NewZapLogger(encoder zapcore.EncoderConfig, level zap.AtomicLevel, opts ...zap.Option) *ZapLogger {
-	core := zapcore.NewCore(
-		zapcore.NewConsoleEncoder(encoder),
-		zapcore.NewMultiWriteSyncer(
-			zapcore.AddSync(os.Stdout),
-		), level)
+// NewZapLogger return a zap logger writing to core.
+func NewZapLogger(core zapcore.Core, opts ...zap.Option) *ZapLogger {
 	zapLogger := zap.New(core, opts...)
 	return &ZapLogger{log: zapLogger, Sync: zapLogger.Sync}
 }
@@ -62,10 +133,10 @@ func (l *ZapLogger) Log(level log.Level, keyvals ...interface{}) error {
 		if keyvals[i] == "msg" {
 			msg = fmt.Sprint(keyvals[i+1])
 		} else {
-			data = append(data, zap.Any(fmt.Sprint(keyvals[i]), fmt.Sprint(keyvals[i+1])))
+			// zap.Any keeps numbers, errors, durations and times typed
+			data = append(data, zap.Any(fmt.Sprint(keyvals[i]), keyvals[i+1]))
 		}
 	}
-	data = append(data, zap.String("logger", "zap"))
 
 	switch level {
 	case log.LevelDebug:
@@ -76,6 +147,9 @@ func (l *ZapLogger) Log(level log.Level, keyvals ...interface{}) error {
 		l.log.Warn(msg, data...)
 	case log.LevelError:
 		l.log.Error(msg, data...)
+	case log.LevelFatal:
+		// syncs and exits, as kratos would right after
+		l.log.Fatal(msg, data...)
 	}
 	return nil
 }
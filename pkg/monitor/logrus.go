@@ -0,0 +1,134 @@
+package monitor
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap/zapcore"
+)
+
+// newLogrus builds the logrus counterpart of the zap setup of NewLogger: text
+// with colors in DEV, JSON elsewhere, plus a JSON file hook when filepath is set.
+// Sampling and the OTLP export of logs are zap only and ignored with a warning.
+func newLogrus(c *conf.Bootstrap) log.Logger {
+	l := logrus.New()
+	l.SetOutput(os.Stdout)
+	if c.GetMetadata().GetEnv() == conf.AppMetadata_DEV {
+		l.SetFormatter(&logrus.TextFormatter{ForceColors: true, FullTimestamp: true})
+	} else {
+		l.SetFormatter(&logrus.JSONFormatter{})
+	}
+
+	var hooks []logrus.Hook
+	if path := c.GetLog().GetFilepath(); path != "" {
+		hooks = append(hooks, NewWriterHook(newRotatingFile(path, c.GetLog().GetRotation()), &logrus.JSONFormatter{}))
+	}
+	logger := NewLogrusLogger(l, hooks...)
+	if c.GetLog().GetSampling().GetEnabled() || c.GetMonitoring().GetLogs().GetEnabled() {
+		log.NewHelper(logger).Warn("LOG: log.sampling and monitoring.logs only apply to zap, ignored with logrus")
+	}
+	return logger
+}
+
+var _ log.Logger = (*LogrusLogger)(nil)
+
+// LogrusLogger is a logger impl backed by logrus. The level of its logrus
+// logger follows SetLevel and LevelHandler like the zap logger.
+type LogrusLogger struct {
+	log *logrus.Logger
+}
+
+// NewLogrusLogger return a logrus logger with hooks added to l, starting at
+// the configured level.
+func NewLogrusLogger(l *logrus.Logger, hooks ...logrus.Hook) *LogrusLogger {
+	l.SetLevel(logrusLevel())
+	for _, h := range hooks {
+		l.AddHook(h)
+	}
+	return &LogrusLogger{log: l}
+}
+
+// Log Implementation of logger interface.
+func (l *LogrusLogger) Log(level log.Level, keyvals ...interface{}) error {
+	// the level may have changed at runtime since the last entry
+	if lv := logrusLevel(); l.log.GetLevel() != lv {
+		l.log.SetLevel(lv)
+	}
+	if len(keyvals) == 0 || len(keyvals)%2 != 0 {
+		l.log.Warn(fmt.Sprint("Keyvalues must appear in pairs: ", keyvals))
+		return nil
+	}
+
+	var msg string
+	fields := make(logrus.Fields, len(keyvals)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		if key == "msg" {
+			msg = fmt.Sprint(keyvals[i+1])
+		} else {
+			fields[key] = keyvals[i+1]
+		}
+	}
+
+	entry := l.log.WithFields(fields)
+	switch level {
+	case log.LevelDebug:
+		entry.Log(logrus.DebugLevel, msg)
+	case log.LevelInfo:
+		entry.Log(logrus.InfoLevel, msg)
+	case log.LevelWarn:
+		entry.Log(logrus.WarnLevel, msg)
+	case log.LevelError:
+		entry.Log(logrus.ErrorLevel, msg)
+	case log.LevelFatal:
+		// exits, as kratos would right after
+		entry.Fatal(msg)
+	}
+	return nil
+}
+
+// logrusLevel returns the logrus counterpart of the level shared with the zap
+// logger, the levels above error only leaving fatal entries.
+func logrusLevel() logrus.Level {
+	switch level.Level() {
+	case zapcore.DebugLevel:
+		return logrus.DebugLevel
+	case zapcore.InfoLevel:
+		return logrus.InfoLevel
+	case zapcore.WarnLevel:
+		return logrus.WarnLevel
+	case zapcore.ErrorLevel:
+		return logrus.ErrorLevel
+	}
+	return logrus.FatalLevel
+}
+
+// WriterHook writes every entry to w with its own formatter, e.g. JSON lines
+// to a file while the logger prints colored text.
+type WriterHook struct {
+	w         io.Writer
+	formatter logrus.Formatter
+}
+
+// NewWriterHook returns a hook writing entries formatted by f to w.
+func NewWriterHook(w io.Writer, f logrus.Formatter) *WriterHook {
+	return &WriterHook{w: w, formatter: f}
+}
+
+func (h *WriterHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *WriterHook) Fire(e *logrus.Entry) error {
+	raw, err := h.formatter.Format(e)
+	if err != nil {
+		return err
+	}
+	_, err = h.w.Write(raw)
+	return err
+}
@@ -0,0 +1,126 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	"layout/internal/conf"
+
+	"go.opentelemetry.io/contrib/bridges/otelzap"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// newLoggerProvider builds the OTel logs pipeline of bc.Monitoring.Logs,
+// sharing the resource of traces and metrics. The cleanup flushes pending records.
+func newLoggerProvider(ctx context.Context, bc *conf.Bootstrap) (*sdklog.LoggerProvider, func(), error) {
+	c := bc.GetMonitoring().GetLogs()
+	exp, err := newLogExporter(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	var opts []sdklog.BatchProcessorOption
+	if d := c.GetExportInterval().AsDuration(); d > 0 {
+		opts = append(opts, sdklog.WithExportInterval(d))
+	}
+	lp := sdklog.NewLoggerProvider(
+		sdklog.WithResource(buildResource(bc.GetMetadata())),
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exp, opts...)),
+	)
+	global.SetLoggerProvider(lp)
+
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := lp.Shutdown(ctx); err != nil {
+			otel.Handle(err)
+		}
+	}
+	return lp, cleanup, nil
+}
+
+func newLogExporter(ctx context.Context, c *conf.Monitoring_Logs) (sdklog.Exporter, error) {
+	if c.GetExporter() == "otlp_grpc" {
+		opts := []otlploggrpc.Option{}
+		if c.GetEndpoint() != "" {
+			opts = append(opts, otlploggrpc.WithEndpoint(c.GetEndpoint()))
+		}
+		if c.GetInsecure() {
+			opts = append(opts, otlploggrpc.WithInsecure())
+		}
+		if len(c.GetHeaders()) > 0 {
+			opts = append(opts, otlploggrpc.WithHeaders(c.GetHeaders()))
+		}
+		if c.GetCompression() == "gzip" {
+			opts = append(opts, otlploggrpc.WithCompressor("gzip"))
+		}
+		return otlploggrpc.New(ctx, opts...)
+	}
+	opts := []otlploghttp.Option{}
+	if c.GetEndpoint() != "" {
+		opts = append(opts, otlploghttp.WithEndpoint(c.GetEndpoint()))
+	}
+	if c.GetInsecure() {
+		opts = append(opts, otlploghttp.WithInsecure())
+	}
+	if len(c.GetHeaders()) > 0 {
+		opts = append(opts, otlploghttp.WithHeaders(c.GetHeaders()))
+	}
+	if c.GetCompression() == "gzip" {
+		opts = append(opts, otlploghttp.WithCompression(otlploghttp.GzipCompression))
+	}
+	return otlploghttp.New(ctx, opts...)
+}
+
+// otelCore bridges zap entries to OTel. It follows the shared level and turns
+// the trace.id and span.id fields added by the kratos logger into the span
+// context of the record, which the bridge reads from a context.Context field.
+type otelCore struct {
+	zapcore.Core
+	level zapcore.LevelEnabler
+}
+
+func newOTelCore(name string, lp *sdklog.LoggerProvider, level zapcore.LevelEnabler) zapcore.Core {
+	return &otelCore{Core: otelzap.NewCore(name, otelzap.WithLoggerProvider(lp)), level: level}
+}
+
+func (c *otelCore) Enabled(l zapcore.Level) bool {
+	return c.level.Enabled(l) && c.Core.Enabled(l)
+}
+
+func (c *otelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &otelCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c *otelCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(e.Level) {
+		return ce.AddCore(e, c)
+	}
+	return ce
+}
+
+func (c *otelCore) Write(e zapcore.Entry, fields []zapcore.Field) error {
+	var sc trace.SpanContextConfig
+	for _, f := range fields {
+		if f.Type != zapcore.StringType {
+			continue
+		}
+		switch f.Key {
+		case "trace.id":
+			sc.TraceID, _ = trace.TraceIDFromHex(f.String)
+		case "span.id":
+			sc.SpanID, _ = trace.SpanIDFromHex(f.String)
+		}
+	}
+	if sc.TraceID.IsValid() && sc.SpanID.IsValid() {
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(sc))
+		fields = append(fields, zap.Field{Key: "ctx", Type: zapcore.SkipType, Interface: ctx})
+	}
+	return c.Core.Write(e, fields)
+}
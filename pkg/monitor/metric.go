@@ -0,0 +1,82 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"layout/internal/conf"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ServerSecondsHistogramName names the latency histogram of the servers, the
+// kratos default ending in _bucket, which the Prometheus exporter adds again.
+const ServerSecondsHistogramName = "server_requests_seconds"
+
+// newOTLPReader returns a reader pushing metrics to the collector of c every
+// interval.
+func newOTLPReader(ctx context.Context, c *conf.Monitoring_Metrics_Otlp) (sdkmetric.Reader, error) {
+	opts := []otlpmetricgrpc.Option{}
+	if c.GetEndpoint() != "" {
+		opts = append(opts, otlpmetricgrpc.WithEndpoint(c.GetEndpoint()))
+	}
+	if c.GetInsecure() {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	if len(c.GetHeaders()) > 0 {
+		opts = append(opts, otlpmetricgrpc.WithHeaders(c.GetHeaders()))
+	}
+	if c.GetCompression() == "gzip" {
+		opts = append(opts, otlpmetricgrpc.WithCompressor("gzip"))
+	}
+	if c.GetTemporality() == "delta" {
+		opts = append(opts, otlpmetricgrpc.WithTemporalitySelector(deltaTemporality))
+	}
+	exp, err := otlpmetricgrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var readerOpts []sdkmetric.PeriodicReaderOption
+	if d := c.GetInterval().AsDuration(); d > 0 {
+		readerOpts = append(readerOpts, sdkmetric.WithInterval(d))
+	}
+	return sdkmetric.NewPeriodicReader(exp, readerOpts...), nil
+}
+
+// deltaTemporality reports deltas for monotonic instruments and histograms,
+// up-down counters stay cumulative as their deltas are of little use.
+func deltaTemporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	switch kind {
+	case sdkmetric.InstrumentKindUpDownCounter, sdkmetric.InstrumentKindObservableUpDownCounter:
+		return metricdata.CumulativeTemporality
+	}
+	return metricdata.DeltaTemporality
+}
+
+// histogramViews turns the configured views into explicit bucket histograms.
+func histogramViews(views []*conf.Monitoring_Metrics_View) ([]sdkmetric.View, error) {
+	res := make([]sdkmetric.View, 0, len(views))
+	for i, v := range views {
+		if !sort.Float64sAreSorted(v.GetBuckets()) {
+			return nil, fmt.Errorf("monitoring.metrics.views[%d]: buckets of %s must be increasing", i, v.GetInstrument())
+		}
+		res = append(res, sdkmetric.NewView(
+			sdkmetric.Instrument{Name: v.GetInstrument()},
+			sdkmetric.Stream{Aggregation: sdkmetric.AggregationExplicitBucketHistogram{Boundaries: v.GetBuckets()}},
+		))
+	}
+	return res, nil
+}
+
+func hasView(views []*conf.Monitoring_Metrics_View, instrument string) bool {
+	for _, v := range views {
+		if v.GetInstrument() == instrument {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package monitor
 import (
 	"context"
 	"errors"
+	"time"
 
 	"layout/internal/conf"
 
@@ -10,7 +11,6 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
@@ -19,8 +19,6 @@ import (
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 	"go.opentelemetry.io/otel/trace"
-
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 )
 
 func buildResource(meta *conf.AppMetadata) *resource.Resource {
@@ -39,58 +37,97 @@ func NewMeter(bc *conf.Bootstrap, provider metric.MeterProvider) (metric.Meter,
 	return provider.Meter(meta.Name), nil
 }
 
-func NewMeterProvider(bc *conf.Bootstrap) (metric.MeterProvider, error) {
+// NewMeterProvider builds a meter provider scraped at /metrics and, when
+// bc.Monitoring.Metrics.Otlp is enabled, pushing to a collector. The cleanup
+// flushes the pending push.
+func NewMeterProvider(ctx context.Context, bc *conf.Bootstrap) (metric.MeterProvider, func(), error) {
 	meta := bc.Metadata
 	if bc.GetMonitoring() == nil {
-		return nil, errors.New("missing Otel configuration")
+		return nil, nil, errors.New("missing Otel configuration")
 	}
 	metricConf := bc.GetMonitoring().Metrics
 	exporter, err := prometheus.New()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if metricConf.EnableExemplar {
 		err = metrics.EnableOTELExemplar()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	provider := sdkmetric.NewMeterProvider(
+	views, err := histogramViews(metricConf.GetViews())
+	if err != nil {
+		return nil, nil, err
+	}
+	// an instrument matching several views is exported once per view, so the
+	// default server latency buckets only apply when not configured
+	if !hasView(metricConf.GetViews(), ServerSecondsHistogramName) {
+		views = append(views, metrics.DefaultSecondsHistogramView(ServerSecondsHistogramName))
+	}
+	opts := []sdkmetric.Option{
 		sdkmetric.WithResource(buildResource(meta)),
 		sdkmetric.WithReader(exporter),
-		sdkmetric.WithView(
-			metrics.DefaultSecondsHistogramView(metrics.DefaultServerSecondsHistogramName),
-		),
-	)
+		sdkmetric.WithView(views...),
+	}
+	if metricConf.GetOtlp().GetEnabled() {
+		reader, err := newOTLPReader(ctx, metricConf.GetOtlp())
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, sdkmetric.WithReader(reader))
+	}
+
+	provider := sdkmetric.NewMeterProvider(opts...)
 	otel.SetMeterProvider(provider)
-	return provider, nil
+
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			otel.Handle(err)
+		}
+	}
+	return provider, cleanup, nil
 }
 
-func NewTracerProvider(ctx context.Context, bc *conf.Bootstrap, textMapPropagator propagation.TextMapPropagator) (trace.TracerProvider, error) {
+// NewTracerProvider builds the tracer provider described by
+// bc.Monitoring.Trace. The cleanup flushes pending spans.
+func NewTracerProvider(ctx context.Context, bc *conf.Bootstrap, textMapPropagator propagation.TextMapPropagator) (trace.TracerProvider, func(), error) {
 	meta := bc.Metadata
-	traceConf := bc.GetMonitoring().Trace
+	traceConf := bc.GetMonitoring().GetTrace()
 
-	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(traceConf.Endpoint)}
-	if traceConf.Insecure {
-		opts = append(opts, otlptracehttp.WithInsecure())
-	}
-	client := otlptracehttp.NewClient(opts...)
-	exp, err := otlptrace.New(ctx, client)
+	exp, closer, err := newSpanExporter(ctx, traceConf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exp),
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(newSampler(traceConf.GetSampler())),
 		sdktrace.WithResource(buildResource(meta)),
-	)
+	}
+	// without an exporter spans are still recorded, so logs keep their trace ids
+	if exp != nil {
+		opts = append(opts, sdktrace.WithBatcher(exp, batchOptions(traceConf.GetBatch())...))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
 
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(textMapPropagator)
 
-	return tp, nil
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			otel.Handle(err)
+		}
+		if closer != nil {
+			closer.Close()
+		}
+	}
+	return tp, cleanup, nil
 }
 
 func NewTracer(bc *conf.Bootstrap, tp trace.TracerProvider) (trace.Tracer, error) {
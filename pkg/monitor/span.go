@@ -0,0 +1,46 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartSpan starts a child of the span in ctx named after the layer and
+// method, e.g. "UsersService.CreateUser". The returned context must be passed
+// down so the next layer nests under this span. Pair it with EndSpan:
+//
+//	ctx, span := monitor.StartSpan(ctx, s.tracer, "UsersService.GetUser", biz.AttrUserID.String(id))
+//	defer func() { monitor.EndSpan(span, err) }()
+func StartSpan(ctx context.Context, tracer trace.Tracer, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err on span and ends it. Client errors (kratos errors with
+// a 4xx code) are recorded with their error.type but leave the status unset,
+// as the span itself did nothing wrong; any other error marks it failed.
+func EndSpan(span trace.Span, err error) {
+	defer span.End()
+	if err == nil {
+		return
+	}
+	se := errors.FromError(err)
+	span.RecordError(err)
+	span.SetAttributes(semconv.ErrorTypeKey.String(errorType(err, se)))
+	if se != nil && se.Code >= 400 && se.Code < 500 {
+		return
+	}
+	span.SetStatus(codes.Error, err.Error())
+}
+
+func errorType(err error, se *errors.Error) string {
+	if se != nil && se.Reason != "" {
+		return se.Reason
+	}
+	return fmt.Sprintf("%T", err)
+}
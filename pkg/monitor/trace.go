@@ -0,0 +1,114 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"layout/internal/conf"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// newSpanExporter builds the exporter selected by c.Exporter. It returns a nil
+// exporter for "none" and a closer for exporters owning a file.
+func newSpanExporter(ctx context.Context, c *conf.Monitoring_Trace) (sdktrace.SpanExporter, io.Closer, error) {
+	switch c.GetExporter() {
+	case "none":
+		return nil, nil, nil
+	case "stdout":
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		return exp, nil, err
+	case "file":
+		if c.GetFilepath() == "" {
+			return nil, nil, fmt.Errorf("monitoring.trace.filepath is required by the file exporter")
+		}
+		if err := os.MkdirAll(filepath.Dir(c.GetFilepath()), 0o755); err != nil {
+			return nil, nil, err
+		}
+		f, err := os.OpenFile(c.GetFilepath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return exp, f, nil
+	case "otlp_grpc":
+		opts := []otlptracegrpc.Option{}
+		if c.GetEndpoint() != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.GetEndpoint()))
+		}
+		if c.GetInsecure() {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(c.GetHeaders()) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(c.GetHeaders()))
+		}
+		if c.GetCompression() == "gzip" {
+			opts = append(opts, otlptracegrpc.WithCompressor("gzip"))
+		}
+		exp, err := otlptrace.New(ctx, otlptracegrpc.NewClient(opts...))
+		return exp, nil, err
+	default:
+		opts := []otlptracehttp.Option{}
+		if c.GetEndpoint() != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(c.GetEndpoint()))
+		}
+		if c.GetInsecure() {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(c.GetHeaders()) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(c.GetHeaders()))
+		}
+		if c.GetCompression() == "gzip" {
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+		exp, err := otlptrace.New(ctx, otlptracehttp.NewClient(opts...))
+		return exp, nil, err
+	}
+}
+
+// newSampler returns the sampler of c, keeping every trace when the ratio is
+// unset.
+func newSampler(c *conf.Monitoring_Trace_Sampler) sdktrace.Sampler {
+	ratio := 1.0
+	if c != nil && c.Ratio != nil {
+		ratio = *c.Ratio
+	}
+	switch c.GetType() {
+	case "always_on":
+		return sdktrace.AlwaysSample()
+	case "always_off":
+		return sdktrace.NeverSample()
+	case "ratio":
+		return sdktrace.TraceIDRatioBased(ratio)
+	default:
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))
+	}
+}
+
+func batchOptions(c *conf.Monitoring_Trace_Batch) []sdktrace.BatchSpanProcessorOption {
+	var opts []sdktrace.BatchSpanProcessorOption
+	if d := c.GetTimeout().AsDuration(); d > 0 {
+		opts = append(opts, sdktrace.WithBatchTimeout(d))
+	}
+	if d := c.GetExportTimeout().AsDuration(); d > 0 {
+		opts = append(opts, sdktrace.WithExportTimeout(d))
+	}
+	if n := c.GetMaxQueueSize(); n > 0 {
+		opts = append(opts, sdktrace.WithMaxQueueSize(int(n)))
+	}
+	if n := c.GetMaxExportBatchSize(); n > 0 {
+		opts = append(opts, sdktrace.WithMaxExportBatchSize(int(n)))
+	}
+	return opts
+}
@@ -0,0 +1,117 @@
+package redact
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// Server masks the sensitive values of the request in the error returned by
+// the handler, so they reach neither the client nor the logs.
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			return reply, Error(err, req)
+		}
+	}
+}
+
+// Error returns err with the sensitive values of req masked in its message
+// and metadata. The cause is dropped when it mentions one of them.
+func Error(err error, req interface{}) error {
+	m, ok := req.(proto.Message)
+	if err == nil || !ok {
+		return err
+	}
+	se := errors.FromError(err)
+	if se == nil {
+		return err
+	}
+	msg := String(se.Message, m)
+	cause := se.Unwrap()
+	masked := cause != nil && String(cause.Error(), m) != cause.Error()
+	if msg == se.Message && !masked && !metadataChanged(se.Metadata, m) {
+		return err
+	}
+
+	res := errors.New(int(se.Code), se.Reason, msg)
+	if len(se.Metadata) > 0 {
+		md := make(map[string]string, len(se.Metadata))
+		for k, v := range se.Metadata {
+			md[k] = String(v, m)
+		}
+		res = res.WithMetadata(md)
+	}
+	if cause != nil && !masked {
+		res = res.WithCause(cause)
+	}
+	return res
+}
+
+func metadataChanged(md map[string]string, m proto.Message) bool {
+	for _, v := range md {
+		if String(v, m) != v {
+			return true
+		}
+	}
+	return false
+}
+
+// Logging is the kratos server logging middleware with the request logged
+// through Message, so sensitive fields never show up in the args.
+func Logging(logger log.Logger) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			var (
+				code      = int32(status.FromGRPCCode(codes.OK))
+				reason    string
+				kind      string
+				operation string
+			)
+			startTime := time.Now()
+			if info, ok := transport.FromServerContext(ctx); ok {
+				kind = info.Kind().String()
+				operation = info.Operation()
+			}
+			reply, err = handler(ctx, req)
+			if se := errors.FromError(err); se != nil {
+				code = se.Code
+				reason = se.Reason
+			}
+			level, stack := log.LevelInfo, ""
+			if err != nil {
+				level, stack = log.LevelError, fmt.Sprintf("%+v", Error(err, req))
+			}
+			log.NewHelper(log.WithContext(ctx, logger)).Log(level,
+				"kind", "server",
+				"component", kind,
+				"operation", operation,
+				"args", args(req),
+				"code", code,
+				"reason", reason,
+				"stack", stack,
+				"latency", time.Since(startTime).Seconds(),
+			)
+			return
+		}
+	}
+}
+
+func args(req interface{}) string {
+	if m, ok := req.(proto.Message); ok {
+		return fmt.Sprint(Message(m))
+	}
+	if stringer, ok := req.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%+v", req)
+}
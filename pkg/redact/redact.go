@@ -0,0 +1,181 @@
+// Package redact masks the fields flagged with the (redact.v1.sensitive)
+// proto option wherever they could leak: logs, span attributes and errors.
+package redact
+
+import (
+	"strings"
+	"sync"
+
+	redactV1 "layout/api/redact/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Mask replaces sensitive values.
+const Mask = "[REDACTED]"
+
+// IsSensitive reports whether fd carries (redact.v1.sensitive) = true.
+func IsSensitive(fd protoreflect.FieldDescriptor) bool {
+	opts := fd.Options()
+	if opts == nil {
+		return false
+	}
+	v, _ := proto.GetExtension(opts, redactV1.E_Sensitive).(bool)
+	return v
+}
+
+var (
+	namesOnce sync.Once
+	names     map[string]bool
+)
+
+// sensitiveNames returns the names of the sensitive fields of every
+// registered message, so that plain values can be matched by name.
+func sensitiveNames() map[string]bool {
+	namesOnce.Do(func() {
+		names = map[string]bool{}
+		protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+			collectNames(fd.Messages())
+			return true
+		})
+	})
+	return names
+}
+
+func collectNames(msgs protoreflect.MessageDescriptors) {
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		fields := md.Fields()
+		for j := 0; j < fields.Len(); j++ {
+			if IsSensitive(fields.Get(j)) {
+				names[string(fields.Get(j).Name())] = true
+			}
+		}
+		collectNames(md.Messages())
+	}
+}
+
+// IsSensitiveName reports whether a field called name is sensitive in any
+// message, e.g. "email". For dotted keys the last segment is checked.
+func IsSensitiveName(name string) bool {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	return sensitiveNames()[strings.ToLower(name)]
+}
+
+// Attr returns a span attribute, masked when key names a sensitive field.
+func Attr(key, value string) attribute.KeyValue {
+	if value != "" && IsSensitiveName(key) {
+		value = Mask
+	}
+	return attribute.String(key, value)
+}
+
+// Message returns m with its sensitive fields masked, m itself when there is
+// nothing to mask. Strings become Mask, other kinds are cleared.
+func Message(m proto.Message) proto.Message {
+	if m == nil || !hasSensitive(m.ProtoReflect()) {
+		return m
+	}
+	c := proto.Clone(m)
+	maskMessage(c.ProtoReflect())
+	return c
+}
+
+func hasSensitive(m protoreflect.Message) bool {
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case IsSensitive(fd):
+			found = true
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					found = hasSensitive(mv.Message())
+					return !found
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len() && !found; i++ {
+					found = hasSensitive(v.List().Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			found = hasSensitive(v.Message())
+		}
+		return !found
+	})
+	return found
+}
+
+func maskMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case IsSensitive(fd):
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(Mask))
+			} else {
+				m.Clear(fd)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					maskMessage(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len(); i++ {
+					maskMessage(v.List().Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			maskMessage(v.Message())
+		}
+		return true
+	})
+}
+
+// values returns the set sensitive string values of m.
+func values(m protoreflect.Message) []string {
+	var res []string
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case IsSensitive(fd) && fd.Kind() == protoreflect.StringKind && !fd.IsMap():
+			if fd.IsList() {
+				for i := 0; i < v.List().Len(); i++ {
+					res = append(res, v.List().Get(i).String())
+				}
+			} else {
+				res = append(res, v.String())
+			}
+		case fd.IsList() && fd.Message() != nil:
+			for i := 0; i < v.List().Len(); i++ {
+				res = append(res, values(v.List().Get(i).Message())...)
+			}
+		case fd.Message() != nil && !fd.IsMap() && !fd.IsList():
+			res = append(res, values(v.Message())...)
+		}
+		return true
+	})
+	return res
+}
+
+// String masks every sensitive value of m found in s.
+func String(s string, m proto.Message) string {
+	if m == nil {
+		return s
+	}
+	for _, v := range values(m.ProtoReflect()) {
+		if v != "" {
+			s = strings.ReplaceAll(s, v, Mask)
+		}
+	}
+	return s
+}
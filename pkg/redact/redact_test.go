@@ -0,0 +1,104 @@
+package redact
+
+import (
+	"testing"
+
+	usersV1 "layout/api/users/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMessage(t *testing.T) {
+	email, password := "ada@example.com", "hunter22"
+	tests := []struct {
+		name string
+		in   proto.Message
+		want proto.Message
+	}{
+		{
+			name: "nothing sensitive",
+			in:   &usersV1.GetUserRequest{Id: "42"},
+			want: &usersV1.GetUserRequest{Id: "42"},
+		},
+		{
+			name: "sensitive fields unset",
+			in:   &usersV1.CreateUserRequest{Username: "ada"},
+			want: &usersV1.CreateUserRequest{Username: "ada"},
+		},
+		{
+			name: "top level fields",
+			in:   &usersV1.CreateUserRequest{Username: "ada", Email: email, Phone: "+3312345678", Password: &password},
+			want: &usersV1.CreateUserRequest{Username: "ada", Email: Mask, Phone: Mask, Password: proto.String(Mask)},
+		},
+		{
+			name: "nested message",
+			in:   &usersV1.ListUsersRequest{Filter: &usersV1.UserFilter{Username: proto.String("ada"), Email: &email}},
+			want: &usersV1.ListUsersRequest{Filter: &usersV1.UserFilter{Username: proto.String("ada"), Email: proto.String(Mask)}},
+		},
+		{
+			name: "repeated messages",
+			in: &usersV1.ListUsersResponse{Users: []*usersV1.User{
+				{Id: "1", Username: "ada", Email: email},
+				{Id: "2", Username: "bob"},
+			}},
+			want: &usersV1.ListUsersResponse{Users: []*usersV1.User{
+				{Id: "1", Username: "ada", Email: Mask},
+				{Id: "2", Username: "bob"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := proto.Clone(tt.in)
+			got := Message(tt.in)
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("Message() = %v, want %v", got, tt.want)
+			}
+			if !proto.Equal(tt.in, before) {
+				t.Errorf("Message() changed its input to %v", tt.in)
+			}
+		})
+	}
+}
+
+func TestAttr(t *testing.T) {
+	tests := []struct {
+		key, value string
+		want       attribute.KeyValue
+	}{
+		{"user.email", "ada@example.com", attribute.String("user.email", Mask)},
+		{"user.phone", "+3312345678", attribute.String("user.phone", Mask)},
+		{"Email", "ada@example.com", attribute.String("Email", Mask)},
+		{"user.search.query", "ada", attribute.String("user.search.query", Mask)},
+		{"user.email", "", attribute.String("user.email", "")},
+		{"user.username", "ada", attribute.String("user.username", "ada")},
+		{"email.domain", "example.com", attribute.String("email.domain", "example.com")},
+	}
+	for _, tt := range tests {
+		if got := Attr(tt.key, tt.value); got != tt.want {
+			t.Errorf("Attr(%q, %q) = %v, want %v", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	req := &usersV1.CreateUserRequest{Username: "ada", Email: "ada@example.com", Phone: "+3312345678"}
+	tests := []struct {
+		name string
+		s    string
+		m    proto.Message
+		want string
+	}{
+		{"no message", "ada@example.com exists", nil, "ada@example.com exists"},
+		{"sensitive values", `email "ada@example.com" or phone +3312345678 taken`, req, `email "[REDACTED]" or phone [REDACTED] taken`},
+		{"other values kept", "username ada taken", req, "username ada taken"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := String(tt.s, tt.m); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Reason is the reason of the errors returned by Validator, the one of the
+// kratos validate middleware.
+const Reason = "VALIDATOR"
+
+// Error is a 400 kratos error listing every violation of a request. On gRPC
+// the violations travel as a google.rpc.BadRequest detail.
+type Error struct {
+	err        *errors.Error
+	Violations []Violation
+}
+
+// NewError returns the error reporting violations.
+func NewError(violations []Violation) *Error {
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		msgs = append(msgs, v.String())
+	}
+	return &Error{
+		err:        errors.BadRequest(Reason, fmt.Sprintf("invalid request: %s", strings.Join(msgs, "; "))),
+		Violations: violations,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap exposes the kratos error to errors.FromError.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus adds the BadRequest detail to the status of the kratos error.
+func (e *Error) GRPCStatus() *status.Status {
+	st := e.err.GRPCStatus()
+	br := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	if ds, err := st.WithDetails(br); err == nil {
+		return ds
+	}
+	return st
+}
+
+// Validator replaces the kratos validate middleware: it checks every rule of
+// the request instead of stopping at the first one and reports them all.
+func Validator() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if m, ok := req.(proto.Message); ok {
+				if violations := Violations(m); len(violations) > 0 {
+					return nil, NewError(violations)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
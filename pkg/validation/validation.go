@@ -0,0 +1,97 @@
+// Package validation turns the errors of protoc-gen-validate into field
+// violations named after the proto fields, for requests and configs alike.
+package validation
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation is a single failed rule.
+type Violation struct {
+	// Field is the proto path of the field, e.g. pagination.page_size.
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+func (v Violation) String() string {
+	return v.Field + ": " + v.Description
+}
+
+// validationError is implemented by the errors generated by protoc-gen-validate.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+// Violations checks m against the rules declared in its proto definition and
+// returns every failed rule, or nil when m is valid or has no rules.
+func Violations(m proto.Message) []Violation {
+	v, ok := m.(interface{ ValidateAll() error })
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+	var violations []Violation
+	collect(m.ProtoReflect().Descriptor(), nil, err, &violations)
+	return violations
+}
+
+func collect(desc protoreflect.MessageDescriptor, path []string, err error, out *[]Violation) {
+	if me, ok := err.(multiError); ok {
+		for _, e := range me.AllErrors() {
+			collect(desc, path, e, out)
+		}
+		return
+	}
+	ve, ok := err.(validationError)
+	if !ok {
+		*out = append(*out, Violation{Field: strings.Join(path, "."), Description: err.Error()})
+		return
+	}
+	name, fd := fieldPath(desc, ve.Field())
+	path = append(path[:len(path):len(path)], name)
+	if ve.Cause() != nil && fd != nil && fd.Message() != nil {
+		collect(fd.Message(), path, ve.Cause(), out)
+		return
+	}
+	*out = append(*out, Violation{Field: strings.Join(path, "."), Description: ve.Reason()})
+}
+
+// fieldPath maps the Go field name reported by protoc-gen-validate, e.g.
+// "AllowOrigins[0]", to its proto name "allow_origins[0]". Required oneofs
+// are reported under the oneof name.
+func fieldPath(desc protoreflect.MessageDescriptor, goName string) (string, protoreflect.FieldDescriptor) {
+	name, index, _ := strings.Cut(goName, "[")
+	if index != "" {
+		index = "[" + index
+	}
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if matches(fd.Name(), name) {
+			return string(fd.Name()) + index, fd
+		}
+	}
+	oneofs := desc.Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		if od := oneofs.Get(i); matches(od.Name(), name) {
+			return string(od.Name()), nil
+		}
+	}
+	return goName, nil
+}
+
+func matches(name protoreflect.Name, goName string) bool {
+	return strings.EqualFold(strings.ReplaceAll(string(name), "_", ""), goName)
+}
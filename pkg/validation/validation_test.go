@@ -0,0 +1,103 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	usersV1 "layout/api/users/v1"
+	"layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func fields(violations []Violation) []string {
+	var res []string
+	for _, v := range violations {
+		res = append(res, v.Field)
+	}
+	return res
+}
+
+func TestViolations(t *testing.T) {
+	tests := []struct {
+		name string
+		m    proto.Message
+		want []string
+	}{
+		{
+			name: "valid",
+			m:    &usersV1.CreateUserRequest{Username: "ada", Email: "ada@example.com", Phone: "+3312345678"},
+		},
+		{
+			name: "every rule reported",
+			m:    &usersV1.CreateUserRequest{Username: "ad", Email: "ada", Phone: "+33"},
+			want: []string{"username", "email", "phone"},
+		},
+		{
+			name: "nested messages",
+			m:    &conf.Server{Http: &conf.Server_HTTP{}},
+			want: []string{"http.addr", "grpc"},
+		},
+		{
+			name: "no rules",
+			m:    &usersV1.ListUsersRequest{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Violations(tt.m)
+			if !reflect.DeepEqual(fields(got), tt.want) {
+				t.Errorf("Violations() = %v, want fields %v", got, tt.want)
+			}
+			for _, v := range got {
+				if v.Description == "" {
+					t.Errorf("violation of %s has no description", v.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidator(t *testing.T) {
+	called := false
+	handler := Validator()(func(context.Context, interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	})
+
+	if _, err := handler(context.Background(), &usersV1.CreateUserRequest{Username: "ada", Email: "ada@example.com", Phone: "+3312345678"}); err != nil || !called {
+		t.Fatalf("valid request: err = %v, handler called = %v", err, called)
+	}
+
+	called = false
+	_, err := handler(context.Background(), &usersV1.CreateUserRequest{Username: "ad", Email: "ada@example.com", Phone: "+33"})
+	if called {
+		t.Error("the handler ran on an invalid request")
+	}
+	if se := errors.FromError(err); se.Code != 400 || se.Reason != Reason {
+		t.Errorf("error = %d %s, want 400 %s", se.Code, se.Reason, Reason)
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("error %v has no gRPC status", err)
+	}
+	var got []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, fv := range br.GetFieldViolations() {
+				if fv.GetDescription() == "" {
+					t.Errorf("field violation of %s has no description", fv.GetField())
+				}
+				got = append(got, fv.GetField())
+			}
+		}
+	}
+	if want := []string{"username", "phone"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BadRequest field violations = %v, want %v", got, want)
+	}
+}